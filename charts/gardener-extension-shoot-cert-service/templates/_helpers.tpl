@@ -12,12 +12,21 @@ apiVersion: shoot-cert-service.extensions.config.gardener.cloud/v1alpha1
 kind: Configuration
 issuerName: {{ required ".Values.certificateConfig.defaultIssuer.name is required" .Values.certificateConfig.defaultIssuer.name }}
 restrictIssuer: {{ required ".Values.certificateConfig.defaultIssuer.restricted is required" .Values.certificateConfig.defaultIssuer.restricted }}
+{{- if .Values.certificateConfig.restrictedDomainsFromDNSProviders }}
+restrictedDomainsFromDNSProviders: {{ .Values.certificateConfig.restrictedDomainsFromDNSProviders }}
+{{- end }}
 {{- if .Values.certificateConfig.defaultRequestsPerDayQuota }}
 defaultRequestsPerDayQuota: {{ .Values.certificateConfig.defaultRequestsPerDayQuota }}
 {{- end }}
 {{- if .Values.certificateConfig.shootIssuers }}
 shootIssuers:
   enabled: {{ .Values.certificateConfig.shootIssuers.enabled }}
+{{- if .Values.certificateConfig.shootIssuers.policy }}
+  policy: {{ .Values.certificateConfig.shootIssuers.policy }}
+{{- end }}
+{{- end }}
+{{- if .Values.certificateConfig.allowDisableGardenIssuer }}
+allowDisableGardenIssuer: {{ .Values.certificateConfig.allowDisableGardenIssuer }}
 {{- end }}
 {{- if .Values.certificateConfig.privateKeyDefaults }}
 privateKeyDefaults:
@@ -31,6 +40,41 @@ privateKeyDefaults:
   sizeECDSA: {{ .Values.certificateConfig.privateKeyDefaults.sizeECDSA }}
 {{- end }}
 {{- end }}
+{{- if .Values.certificateConfig.privateKeyPolicy }}
+privateKeyPolicy:
+{{ toYaml .Values.certificateConfig.privateKeyPolicy | indent 2 }}
+{{- end }}
+{{- if .Values.certificateConfig.customIssuerPolicy }}
+customIssuerPolicy:
+{{ toYaml .Values.certificateConfig.customIssuerPolicy | indent 2 }}
+{{- end }}
+{{- if .Values.certificateConfig.issuerCatalog }}
+issuerCatalog:
+{{ toYaml .Values.certificateConfig.issuerCatalog | indent 2 }}
+{{- end }}
+{{- if .Values.certificateConfig.defaultIssuerFallback }}
+defaultIssuerFallback:
+{{ toYaml .Values.certificateConfig.defaultIssuerFallback | indent 2 }}
+{{- end }}
+{{- if .Values.certificateConfig.complianceProfiles }}
+complianceProfiles:
+{{ toYaml .Values.certificateConfig.complianceProfiles | indent 2 }}
+{{- end }}
+{{- if .Values.certificateConfig.defaultComplianceProfile }}
+defaultComplianceProfile: {{ .Values.certificateConfig.defaultComplianceProfile }}
+{{- end }}
+{{- if .Values.certificateConfig.proxy }}
+proxy:
+{{ toYaml .Values.certificateConfig.proxy | indent 2 }}
+{{- end }}
+{{- if .Values.certificateConfig.overrides }}
+overrides:
+{{ toYaml .Values.certificateConfig.overrides | indent 2 }}
+{{- end }}
+{{- if .Values.certificateConfig.shootACMELimits }}
+shootACMELimits:
+{{ toYaml .Values.certificateConfig.shootACMELimits | indent 2 }}
+{{- end }}
 {{- if .Values.certificateConfig.inClusterACMEServerNamespaceMatchLabel }}
 inClusterACMEServerNamespaceMatchLabel:
 {{ toYaml .Values.certificateConfig.inClusterACMEServerNamespaceMatchLabel | indent 2 }}
@@ -42,9 +86,22 @@ acme:
   {{- if .Values.certificateConfig.defaultIssuer.acme.propagationTimeout }}
   propagationTimeout: {{ .Values.certificateConfig.defaultIssuer.acme.propagationTimeout }}
   {{- end }}
+  {{- if .Values.certificateConfig.defaultIssuer.acme.precheckAdditionalWait }}
+  precheckAdditionalWait: {{ .Values.certificateConfig.defaultIssuer.acme.precheckAdditionalWait }}
+  {{- end }}
+  {{- if .Values.certificateConfig.defaultIssuer.acme.preferredChain }}
+  preferredChain: {{ .Values.certificateConfig.defaultIssuer.acme.preferredChain | quote }}
+  {{- end }}
+  {{- if .Values.certificateConfig.defaultIssuer.acme.keyType }}
+  keyType: {{ .Values.certificateConfig.defaultIssuer.acme.keyType }}
+  {{- end }}
   {{- if .Values.certificateConfig.defaultIssuer.acme.privateKey }}
   privateKey: |
 {{ .Values.certificateConfig.defaultIssuer.acme.privateKey | trim | indent 4 }}
+  {{- end }}
+  {{- if .Values.certificateConfig.defaultIssuer.acme.privateKeySecretRef }}
+  privateKeySecretRef:
+{{ toYaml .Values.certificateConfig.defaultIssuer.acme.privateKeySecretRef | indent 4 }}
   {{- end }}
   {{- if .Values.certificateConfig.precheckNameservers }}
   precheckNameservers: {{ .Values.certificateConfig.precheckNameservers }}
@@ -58,11 +115,24 @@ acme:
   {{- if .Values.certificateConfig.defaultIssuer.acme.skipDNSChallengeValidation }}
   skipDNSChallengeValidation: true
   {{- end }}
+  {{- if .Values.certificateConfig.defaultIssuer.acme.externalAccountBinding }}
+  externalAccountBinding:
+{{ toYaml .Values.certificateConfig.defaultIssuer.acme.externalAccountBinding | indent 4 }}
+  {{- end }}
+  {{- if .Values.certificateConfig.defaultIssuer.acme.domains }}
+  domains:
+{{ toYaml .Values.certificateConfig.defaultIssuer.acme.domains | indent 4 }}
+  {{- end }}
 {{- end }}
 {{- if .Values.certificateConfig.defaultIssuer.ca }}
 ca:
+  {{- if .Values.certificateConfig.defaultIssuer.ca.secretRef }}
+  secretRef:
+{{ toYaml .Values.certificateConfig.defaultIssuer.ca.secretRef | indent 4 }}
+  {{- else }}
   certificate: {{- toYaml (required ".Values.certificateConfig.defaultIssuer.ca.certificate is required" .Values.certificateConfig.defaultIssuer.ca.certificate) | indent 2 }}
   certificateKey: {{- toYaml (required ".Values.certificateConfig.defaultIssuer.ca.certificateKey is required" .Values.certificateConfig.defaultIssuer.ca.certificateKey) | indent 2 }}
+  {{- end }}
 {{- end }}
 {{- end }}
 
@@ -21,9 +21,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	serviceinstall "github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/install"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/csrsigner"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/controlplane"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shoot"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/healthcheck"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/secretreplication"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/trustbundle"
 )
 
 // NewServiceControllerCommand creates a new command that is used to start the Certificate Service controller.
@@ -69,6 +72,7 @@ func (o *Options) run(ctx context.Context) error {
 			DisableFor: []client.Object{
 				&corev1.Secret{},    // applied for ManagedResources
 				&corev1.ConfigMap{}, // applied for monitoring config
+				&corev1.Service{},   // read for the kube-apiserver service excluded from the proxy
 			},
 		},
 	}
@@ -98,8 +102,15 @@ func (o *Options) run(ctx context.Context) error {
 	ctrlConfig.ApplyHealthCheckConfig(&healthcheck.DefaultAddOptions.HealthCheckConfig)
 	ctrlConfig.Apply(&shoot.DefaultAddOptions.ServiceConfig)
 	ctrlConfig.Apply(&controlplane.DefaultAddOptions.ServiceConfig)
+	ctrlConfig.Apply(&csrsigner.DefaultAddOptions.ServiceConfig)
+	ctrlConfig.ApplyCSRSignerSyncPeriod(&csrsigner.DefaultAddOptions.SyncPeriod)
+	ctrlConfig.Apply(&secretreplication.DefaultAddOptions.ServiceConfig)
+	ctrlConfig.Apply(&trustbundle.DefaultAddOptions.ServiceConfig)
 	o.shootControllerOptions.Completed().Apply(&shoot.DefaultAddOptions.ControllerOptions)
 	o.controlPlaneControllerOptions.Completed().Apply(&controlplane.DefaultAddOptions.ControllerOptions)
+	o.shootControllerOptions.Completed().Apply(&csrsigner.DefaultAddOptions.ControllerOptions)
+	o.shootControllerOptions.Completed().Apply(&secretreplication.DefaultAddOptions.ControllerOptions)
+	o.shootControllerOptions.Completed().Apply(&trustbundle.DefaultAddOptions.ControllerOptions)
 	o.healthOptions.Completed().Apply(&healthcheck.DefaultAddOptions.Controller)
 	reconcilerConfig := o.reconcileOptions.Completed()
 	reconcilerConfig.Apply(&shoot.DefaultAddOptions.IgnoreOperationAnnotation)
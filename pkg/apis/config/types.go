@@ -6,6 +6,7 @@ package config
 
 import (
 	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -19,23 +20,55 @@ type Configuration struct {
 	IssuerName string
 	// RestrictIssuer restricts the ACME issuer to shoot related domains.
 	RestrictIssuer *bool
+	// RestrictedDomainsFromDNSProviders extends the domains of the restricted issuer by the included domains of the DNS providers of the shoot.
+	RestrictedDomainsFromDNSProviders *bool
 	// DefaultRequestsPerDayQuota restricts the certificate requests per issuer (can be overriden in issuer spec)
 	DefaultRequestsPerDayQuota *int32
 	// ShootIssuers contains enablement for issuers on shoot cluster
 	ShootIssuers *ShootIssuers
+	// AllowDisableGardenIssuer allows shoot owners to disable the issuer of this configuration in the shoot manifest.
+	AllowDisableGardenIssuer *bool
 	// ACME contains ACME related configuration.
 	ACME *ACME
+	// ShootACMELimits contains the limits for the ACME settings in the shoot manifest.
+	ShootACMELimits *ShootACMELimits
 	// CA contains CA related configuration.
 	CA *CA
 	// HealthCheckConfig is the config for the health check controller.
 	HealthCheckConfig *extensionsconfigv1alpha1.HealthCheckConfig
 	// PrivateKeyDefaults default algorithm and sizes for certificate private keys.
 	PrivateKeyDefaults *PrivateKeyDefaults
+	// PrivateKeyPolicy restricts the private keys of certificates in the shoot clusters.
+	PrivateKeyPolicy *PrivateKeyPolicy
+	// CustomIssuerPolicy restricts the custom issuers in the shoot manifest.
+	CustomIssuerPolicy *CustomIssuerPolicy
+	// IssuerCatalog contains issuer templates, which can be referenced by name in the shoot manifest.
+	IssuerCatalog []CatalogIssuer
+	// DefaultIssuerFallback configures issuers replacing the issuer of this configuration as default issuer of shoot clusters
+	// while it is not ready or rate limited.
+	DefaultIssuerFallback *DefaultIssuerFallback
+	// ComplianceProfiles are named sets of constraints for the issuance of certificates.
+	ComplianceProfiles []ComplianceProfile
+	// DefaultComplianceProfile is the name of the compliance profile applied if no profile is bound by seed selector.
+	DefaultComplianceProfile *string
+	// Proxy configures a proxy for the outgoing connections of the cert-controller-manager.
+	Proxy *Proxy
+	// Overrides are overrides of this configuration for the shoots of selected seeds. All matching overrides are applied in the given order.
+	Overrides []ConfigurationOverride
 	// InClusterACMEServerNamespaceMatchLabel is the match label used to create a network policy to allow egress from the "cert-controller-manager" to a namespace with these labels.
 	// It can be set to allow access to an in-cluster ACME server from the cert-controller-manager.
 	InClusterACMEServerNamespaceMatchLabel map[string]string
 }
 
+const (
+	// DefaultPrivateKeyAlgorithm is the default algorithm for certificate private keys if not configured.
+	DefaultPrivateKeyAlgorithm = "RSA"
+	// DefaultPrivateKeySizeRSA is the default size for RSA algorithm if not configured.
+	DefaultPrivateKeySizeRSA = 3072
+	// DefaultPrivateKeySizeECDSA is the default size for ECDSA algorithm if not configured.
+	DefaultPrivateKeySizeECDSA = 384
+)
+
 // PrivateKeyDefaults default algorithm and sizes for certificate private keys.
 type PrivateKeyDefaults struct {
 	// Algorithm is the default algorithm ('RSA' or 'ECDSA')
@@ -46,11 +79,162 @@ type PrivateKeyDefaults struct {
 	SizeECDSA *int
 }
 
+// PrivateKeyPolicy restricts the private keys of certificates in the shoot clusters.
+type PrivateKeyPolicy struct {
+	// AllowedAlgorithms are the allowed algorithms ('RSA' or 'ECDSA'). If not specified, both algorithms are allowed.
+	AllowedAlgorithms []string
+	// MinSizeRSA is the minimum size for RSA algorithm.
+	MinSizeRSA *int
+	// MinSizeECDSA is the minimum size for ECDSA algorithm.
+	MinSizeECDSA *int
+}
+
+// CustomIssuerPolicy restricts the custom issuers in the shoot manifest.
+// Server URLs are compared case-insensitively for scheme and host, ignoring a default port and a trailing slash.
+// Issuers on the shoot cluster are disabled if allowed or denied servers are specified.
+type CustomIssuerPolicy struct {
+	// AllowedServers are the directory URLs of the ACME servers allowed for custom issuers.
+	// If not specified, all servers are allowed which are not denied.
+	AllowedServers []string
+	// DeniedServers are the directory URLs of the ACME servers not allowed for custom issuers.
+	DeniedServers []string
+	// MaxIssuers is the maximum number of custom issuers per shoot.
+	MaxIssuers *int
+	// ExternalAccountBindingRequiredServers are the directory URLs of the ACME servers requiring an external account binding.
+	ExternalAccountBindingRequiredServers []string
+	// RestrictPrecheckNameservers restricts the precheck nameservers in the shoot manifest to the approved nameservers.
+	RestrictPrecheckNameservers bool
+	// ApprovedPrecheckNameservers are the nameservers approved for prechecking DNS challenges in addition to the
+	// precheck nameservers of the ACME configuration.
+	ApprovedPrecheckNameservers []string
+}
+
+// DefaultIssuerFallback configures issuers replacing the issuer of this configuration as default issuer of shoot clusters.
+type DefaultIssuerFallback struct {
+	// Issuers are the fallback issuers in order of preference. The first issuer which is ready and not rate limited
+	// is used as default issuer, if the issuer of this configuration is not.
+	Issuers []CatalogIssuer
+	// MinHoldDuration is the minimum time a fallback issuer stays the default issuer before switching back to a preferred issuer.
+	MinHoldDuration *metav1.Duration
+}
+
+// CatalogIssuer is an issuer template of the operator. Shoots can use it without providing the credentials themselves.
+type CatalogIssuer struct {
+	// Name is the name of the template.
+	Name string
+	// ShootSelector restricts the template to the shoots matching the label selector.
+	ShootSelector *metav1.LabelSelector
+	// SeedSelector restricts the template to the shoots of the seeds matching the label selector.
+	SeedSelector *metav1.LabelSelector
+	// ACME configures an ACME issuer. Exactly one of ACME or CA must be specified.
+	ACME *CatalogACME
+	// CA configures a CA issuer. The certificate and private key of the CA must be referenced with `secretRef`.
+	CA *CA
+}
+
+// CatalogACME contains the configuration of an ACME issuer of the issuer catalog.
+type CatalogACME struct {
+	// Email is the e-mail address used for the ACME account.
+	Email string
+	// Server is the directory URL of the ACME server.
+	Server string
+	// PrivateKeySecretRef references a secret containing the private key of the ACME account in the data key `privateKey`.
+	// The secret must be located in the namespace of the extension (default) or in the `garden` namespace.
+	// If not specified, the account is registered automatically.
+	PrivateKeySecretRef *corev1.SecretReference
+	// ExternalAccountBinding is the external account binding of the ACME account.
+	ExternalAccountBinding *ACMEExternalAccountBinding
+	// SkipDNSChallengeValidation skips the DNS challenge validation.
+	SkipDNSChallengeValidation *bool
+	// PrecheckNameservers overwrites the default precheck nameservers used for checking DNS propagation.
+	PrecheckNameservers []string
+	// Domains restricts the domains of certificate requests. The domains of an issuer in the shoot manifest
+	// referencing the template must be within the included domains.
+	Domains *DNSSelection
+}
+
+// ComplianceProfile is a named set of constraints for the issuance of certificates, e.g. for regulatory requirements.
+type ComplianceProfile struct {
+	// Name is the name of the profile.
+	Name string
+	// SeedSelector binds the profile to all shoots of the seeds matching the label selector.
+	SeedSelector *metav1.LabelSelector
+	// PrivateKeys restricts the algorithms and sizes of the private keys of certificates.
+	PrivateKeys *PrivateKeyPolicy
+	// AllowedIssuerServers are the directory URLs of the allowed ACME servers. If not specified, all servers are allowed.
+	// Issuers on the shoot cluster are disabled if the servers are restricted.
+	AllowedIssuerServers []string
+	// MaxCertificateDuration is the maximum lifetime of certificates requested in the shoot manifest.
+	MaxCertificateDuration *metav1.Duration
+	// AllowSkipDNSChallengeValidation allows ACME issuers skipping the validation of DNS challenges.
+	AllowSkipDNSChallengeValidation bool
+}
+
+// Proxy configures a proxy for the outgoing connections of the cert-controller-manager, e.g. to the ACME server.
+type Proxy struct {
+	// HTTPProxy is the proxy URL for HTTP requests.
+	HTTPProxy *string
+	// HTTPSProxy is the proxy URL for HTTPS requests.
+	HTTPSProxy *string
+	// NoProxy is a comma-separated list of hosts, domains and CIDRs excluded from the proxy.
+	NoProxy *string
+}
+
+// ConfigurationOverride overrides parts of the configuration for the shoots of selected seeds.
+// A seed is selected if it matches all specified selectors.
+type ConfigurationOverride struct {
+	// Name is the name of the override.
+	Name string
+	// SeedNames selects seeds by name.
+	SeedNames []string
+	// SeedSelector selects seeds by labels.
+	SeedSelector *metav1.LabelSelector
+	// Regions selects seeds by provider region.
+	Regions []string
+	// ACME overrides the specified fields of the ACME configuration.
+	ACME *ACME
+	// Proxy overrides the specified fields of the proxy configuration.
+	Proxy *Proxy
+}
+
+// ShootACMELimits contains the limits for the ACME settings in the shoot manifest.
+type ShootACMELimits struct {
+	// PropagationTimeout limits the timeout for DNS01 challenges.
+	// If no maximum is configured, the timeout is limited to 10m.
+	PropagationTimeout *DurationLimits
+	// PrecheckAdditionalWait limits the additional wait time after the DNS propagation check.
+	// If no maximum is configured, the wait time is limited to 2m.
+	PrecheckAdditionalWait *DurationLimits
+}
+
+// DurationLimits contains the allowed range of a duration.
+type DurationLimits struct {
+	// Min is the minimum duration.
+	Min *metav1.Duration
+	// Max is the maximum duration.
+	Max *metav1.Duration
+}
+
 // ShootIssuers holds enablement for issuers on shoot cluster
 type ShootIssuers struct {
+	// Enabled is the enablement of issuers on shoot cluster if not overwritten in the shoot manifest.
 	Enabled bool
+	// Policy controls if the enablement can be overwritten in the shoot manifest.
+	Policy ShootIssuersPolicy
 }
 
+// ShootIssuersPolicy controls if the enablement of issuers on shoot cluster can be overwritten in the shoot manifest.
+type ShootIssuersPolicy string
+
+const (
+	// ShootIssuersPolicyDefault uses the enablement of the service configuration as default, which can be overwritten in the shoot manifest.
+	ShootIssuersPolicyDefault ShootIssuersPolicy = "Default"
+	// ShootIssuersPolicyForbid disables issuers on shoot cluster for all shoots. Enabling them in the shoot manifest is rejected.
+	ShootIssuersPolicyForbid ShootIssuersPolicy = "Forbid"
+	// ShootIssuersPolicyForce enables issuers on shoot cluster for all shoots. Disabling them in the shoot manifest is rejected.
+	ShootIssuersPolicyForce ShootIssuersPolicy = "Force"
+)
+
 // ACME holds information about the ACME issuer used for the certificate service.
 type ACME struct {
 	// Email is the e-mail address used for the ACME issuer.
@@ -59,8 +243,13 @@ type ACME struct {
 	Server string
 	// PrivateKey is the key used for the ACME issuer.
 	PrivateKey *string // #nosec G117 -- false positive: optional field to provide private key for ACME issuer
+	// PrivateKeySecretRef references a secret containing the key used for the ACME issuer in the data key `privateKey`.
+	// The secret must be located in the namespace of the extension (default) or in the `garden` namespace.
+	PrivateKeySecretRef *corev1.SecretReference
 	// PropagationTimeout is the timeout for DNS01 challenges.
 	PropagationTimeout *metav1.Duration
+	// PrecheckAdditionalWait is the additional wait time after the DNS propagation check of DNS01 challenges.
+	PrecheckAdditionalWait *metav1.Duration
 	// PrecheckNameservers is used to specify a comma-separated list of DNS servers for checking availability for DNS
 	// challenge before calling ACME CA
 	PrecheckNameservers *string
@@ -70,6 +259,33 @@ type ACME struct {
 	DeactivateAuthorizations *bool
 	// SkipDNSChallengeValidation skips the DNS challenge validation
 	SkipDNSChallengeValidation *bool
+	// ExternalAccountBinding is the external account binding of the ACME account, required by some ACME servers.
+	ExternalAccountBinding *ACMEExternalAccountBinding
+	// Domains optionally specifies domains allowed or forbidden for certificate requests.
+	Domains *DNSSelection
+	// PreferredChain is the Subject Common Name of the issuer of the preferred certificate chain, if the ACME server offers
+	// alternate chains. It is the default for the certificates maintained by the extension in the shoot clusters using this issuer.
+	PreferredChain *string
+	// KeyType is the type of the private key ('RSA2048', 'RSA3072', 'RSA4096', 'ECDSA256' or 'ECDSA384').
+	// It is the default for the certificates maintained by the extension in the shoot clusters using this issuer.
+	KeyType *string
+}
+
+// ACMEExternalAccountBinding references the external account binding of the ACME account of the issuer.
+type ACMEExternalAccountBinding struct {
+	// KeyID is the ID of the CA key the external account is bound to.
+	KeyID string
+	// KeySecretRef references a secret containing the base64url encoded MAC key of the external account in the data key `hmacKey`.
+	// The secret must be located in the namespace of the extension (default) or in the `garden` namespace.
+	KeySecretRef *corev1.SecretReference
+}
+
+// DNSSelection is a restriction on the domains to be allowed or forbidden for certificate requests.
+type DNSSelection struct {
+	// Include are domain names to be included (optional).
+	Include []string
+	// Exclude are domain names to be excluded (optional).
+	Exclude []string
 }
 
 type CA struct {
@@ -77,4 +293,8 @@ type CA struct {
 	Certificate string
 	// CertificateKey is the private certificate key of the CA in PEM format.
 	CertificateKey string
+	// SecretRef references a secret of type `kubernetes.io/tls` containing the certificate and private key of the CA.
+	// The secret must be located in the namespace of the extension (default) or in the `garden` namespace.
+	// If specified, Certificate and CertificateKey must be empty.
+	SecretRef *corev1.SecretReference
 }
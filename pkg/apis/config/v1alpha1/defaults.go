@@ -5,6 +5,9 @@
 package v1alpha1
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -17,4 +20,10 @@ func SetDefaults_Configuration(obj *Configuration) {
 	if obj.RestrictIssuer == nil {
 		obj.RestrictIssuer = new(true)
 	}
+	if obj.ShootIssuers != nil && obj.ShootIssuers.Policy == "" {
+		obj.ShootIssuers.Policy = ShootIssuersPolicyDefault
+	}
+	if obj.DefaultIssuerFallback != nil && obj.DefaultIssuerFallback.MinHoldDuration == nil {
+		obj.DefaultIssuerFallback.MinHoldDuration = &metav1.Duration{Duration: time.Hour}
+	}
 }
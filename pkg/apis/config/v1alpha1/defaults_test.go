@@ -5,10 +5,13 @@
 package v1alpha1_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config/v1alpha1"
 )
@@ -24,4 +27,30 @@ var _ = Describe("Defaults", func() {
 			Entry("should remain false", &Configuration{RestrictIssuer: new(false)}, PointTo(BeFalse())),
 		)
 	})
+
+	Context("Shoot issuers", func() {
+		DescribeTable("#SetDefaults_Config", func(config *Configuration, matcher gomegatypes.GomegaMatcher) {
+			SetDefaults_Configuration(config)
+			Expect(config.ShootIssuers).To(matcher)
+		},
+			Entry("should remain nil", &Configuration{}, BeNil()),
+			Entry("should set policy to Default if empty", &Configuration{ShootIssuers: &ShootIssuers{Enabled: true}},
+				PointTo(MatchFields(IgnoreExtras, Fields{"Enabled": BeTrue(), "Policy": Equal(ShootIssuersPolicyDefault)}))),
+			Entry("should keep policy", &Configuration{ShootIssuers: &ShootIssuers{Policy: ShootIssuersPolicyForce}},
+				PointTo(MatchFields(IgnoreExtras, Fields{"Policy": Equal(ShootIssuersPolicyForce)}))),
+		)
+	})
+
+	Context("Default issuer fallback", func() {
+		DescribeTable("#SetDefaults_Config", func(config *Configuration, matcher gomegatypes.GomegaMatcher) {
+			SetDefaults_Configuration(config)
+			Expect(config.DefaultIssuerFallback).To(matcher)
+		},
+			Entry("should remain nil", &Configuration{}, BeNil()),
+			Entry("should set minimum hold duration to 1h if nil", &Configuration{DefaultIssuerFallback: &DefaultIssuerFallback{}},
+				PointTo(MatchFields(IgnoreExtras, Fields{"MinHoldDuration": PointTo(Equal(metav1.Duration{Duration: time.Hour}))}))),
+			Entry("should keep minimum hold duration", &Configuration{DefaultIssuerFallback: &DefaultIssuerFallback{MinHoldDuration: &metav1.Duration{Duration: 10 * time.Minute}}},
+				PointTo(MatchFields(IgnoreExtras, Fields{"MinHoldDuration": PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute}))}))),
+		)
+	})
 })
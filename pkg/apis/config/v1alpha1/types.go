@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	configv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -21,15 +22,27 @@ type Configuration struct {
 	// RestrictIssuer restricts the ACME issuer to shoot related domains.
 	// +optional
 	RestrictIssuer *bool `json:"restrictIssuer,omitempty"`
+	// RestrictedDomainsFromDNSProviders extends the domains of the restricted issuer by the included domains of the DNS providers of the shoot.
+	// By default, the restricted issuer is only allowed for the shoot domain and its subdomains.
+	// +optional
+	RestrictedDomainsFromDNSProviders *bool `json:"restrictedDomainsFromDNSProviders,omitempty"`
 	// DefaultRequestsPerDayQuota restricts the certificate requests per issuer (can be overriden in issuer spec)
 	// +optional
 	DefaultRequestsPerDayQuota *int32 `json:"defaultRequestsPerDayQuota,omitempty"`
 	// ShootIssuers contains enablement for issuers on shoot cluster
 	// +optional
 	ShootIssuers *ShootIssuers `json:"shootIssuers,omitempty"`
+	// AllowDisableGardenIssuer allows shoot owners to disable the issuer of this configuration in the shoot manifest.
+	// Defaults to false.
+	// +optional
+	AllowDisableGardenIssuer *bool `json:"allowDisableGardenIssuer,omitempty"`
 	// ACME contains the ACME default issuer related configuration. Either ACME or CA must be set.
 	// +optional
 	ACME *ACME `json:"acme,omitempty"`
+	// ShootACMELimits contains the limits for the ACME settings in the shoot manifest.
+	// If not specified, any positive durations are allowed.
+	// +optional
+	ShootACMELimits *ShootACMELimits `json:"shootACMELimits,omitempty"`
 	// CA contains the CA default issuer related configuration. Either ACME or CA must be set.
 	// +optional
 	CA *CA `json:"ca,omitempty"`
@@ -39,6 +52,31 @@ type Configuration struct {
 	// PrivateKeyDefaults default algorithm and sizes for certificate private keys.
 	// +optional
 	PrivateKeyDefaults *PrivateKeyDefaults `json:"privateKeyDefaults,omitempty"`
+	// PrivateKeyPolicy restricts the private keys of certificates in the shoot clusters.
+	// +optional
+	PrivateKeyPolicy *PrivateKeyPolicy `json:"privateKeyPolicy,omitempty"`
+	// CustomIssuerPolicy restricts the custom issuers in the shoot manifest.
+	// +optional
+	CustomIssuerPolicy *CustomIssuerPolicy `json:"customIssuerPolicy,omitempty"`
+	// IssuerCatalog contains issuer templates, which can be referenced by name in the shoot manifest.
+	// +optional
+	IssuerCatalog []CatalogIssuer `json:"issuerCatalog,omitempty"`
+	// DefaultIssuerFallback configures issuers replacing the issuer of this configuration as default issuer of shoot clusters
+	// while it is not ready or rate limited.
+	// +optional
+	DefaultIssuerFallback *DefaultIssuerFallback `json:"defaultIssuerFallback,omitempty"`
+	// ComplianceProfiles are named sets of constraints for the issuance of certificates.
+	// +optional
+	ComplianceProfiles []ComplianceProfile `json:"complianceProfiles,omitempty"`
+	// DefaultComplianceProfile is the name of the compliance profile applied if no profile is bound by seed selector.
+	// +optional
+	DefaultComplianceProfile *string `json:"defaultComplianceProfile,omitempty"`
+	// Proxy configures a proxy for the outgoing connections of the cert-controller-manager.
+	// +optional
+	Proxy *Proxy `json:"proxy,omitempty"`
+	// Overrides are overrides of this configuration for the shoots of selected seeds. All matching overrides are applied in the given order.
+	// +optional
+	Overrides []ConfigurationOverride `json:"overrides,omitempty"`
 	// InClusterACMEServerNamespaceMatchLabel is the match label used to create a network policy to allow egress from the "cert-controller-manager" to a namespace with these labels.
 	// It can be set to allow access to an in-cluster ACME server from the cert-controller-manager.
 	// +optional
@@ -58,11 +96,201 @@ type PrivateKeyDefaults struct {
 	SizeECDSA *int `json:"sizeECDSA,omitempty"`
 }
 
+// PrivateKeyPolicy restricts the private keys of certificates in the shoot clusters.
+type PrivateKeyPolicy struct {
+	// AllowedAlgorithms are the allowed algorithms ('RSA' or 'ECDSA'). If not specified, both algorithms are allowed.
+	// +optional
+	AllowedAlgorithms []string `json:"allowedAlgorithms,omitempty"`
+	// MinSizeRSA is the minimum size for RSA algorithm.
+	// +optional
+	MinSizeRSA *int `json:"minSizeRSA,omitempty"`
+	// MinSizeECDSA is the minimum size for ECDSA algorithm.
+	// +optional
+	MinSizeECDSA *int `json:"minSizeECDSA,omitempty"`
+}
+
+// CustomIssuerPolicy restricts the custom issuers in the shoot manifest.
+// Server URLs are compared case-insensitively for scheme and host, ignoring a default port and a trailing slash.
+// Issuers on the shoot cluster are disabled if allowed or denied servers are specified.
+type CustomIssuerPolicy struct {
+	// AllowedServers are the directory URLs of the ACME servers allowed for custom issuers.
+	// If not specified, all servers are allowed which are not denied.
+	// +optional
+	AllowedServers []string `json:"allowedServers,omitempty"`
+	// DeniedServers are the directory URLs of the ACME servers not allowed for custom issuers.
+	// +optional
+	DeniedServers []string `json:"deniedServers,omitempty"`
+	// MaxIssuers is the maximum number of custom issuers per shoot.
+	// +optional
+	MaxIssuers *int `json:"maxIssuers,omitempty"`
+	// ExternalAccountBindingRequiredServers are the directory URLs of the ACME servers requiring an external account binding.
+	// +optional
+	ExternalAccountBindingRequiredServers []string `json:"externalAccountBindingRequiredServers,omitempty"`
+	// RestrictPrecheckNameservers restricts the precheck nameservers in the shoot manifest to the approved nameservers.
+	// +optional
+	RestrictPrecheckNameservers bool `json:"restrictPrecheckNameservers,omitempty"`
+	// ApprovedPrecheckNameservers are the nameservers approved for prechecking DNS challenges in addition to the
+	// precheck nameservers of the ACME configuration.
+	// +optional
+	ApprovedPrecheckNameservers []string `json:"approvedPrecheckNameservers,omitempty"`
+}
+
+// DefaultIssuerFallback configures issuers replacing the issuer of this configuration as default issuer of shoot clusters.
+type DefaultIssuerFallback struct {
+	// Issuers are the fallback issuers in order of preference. The first issuer which is ready and not rate limited
+	// is used as default issuer, if the issuer of this configuration is not.
+	Issuers []CatalogIssuer `json:"issuers"`
+	// MinHoldDuration is the minimum time a fallback issuer stays the default issuer before switching back to a preferred issuer.
+	// Defaults to 1h.
+	// +optional
+	MinHoldDuration *metav1.Duration `json:"minHoldDuration,omitempty"`
+}
+
+// CatalogIssuer is an issuer template of the operator. Shoots can use it without providing the credentials themselves.
+type CatalogIssuer struct {
+	// Name is the name of the template.
+	Name string `json:"name"`
+	// ShootSelector restricts the template to the shoots matching the label selector.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty"`
+	// SeedSelector restricts the template to the shoots of the seeds matching the label selector.
+	// +optional
+	SeedSelector *metav1.LabelSelector `json:"seedSelector,omitempty"`
+	// ACME configures an ACME issuer. Exactly one of ACME or CA must be specified.
+	// +optional
+	ACME *CatalogACME `json:"acme,omitempty"`
+	// CA configures a CA issuer. The certificate and private key of the CA must be referenced with `secretRef`.
+	// +optional
+	CA *CA `json:"ca,omitempty"`
+}
+
+// CatalogACME contains the configuration of an ACME issuer of the issuer catalog.
+type CatalogACME struct {
+	// Email is the e-mail address used for the ACME account.
+	Email string `json:"email"`
+	// Server is the directory URL of the ACME server.
+	Server string `json:"server"`
+	// PrivateKeySecretRef references a secret containing the private key of the ACME account in the data key `privateKey`.
+	// The secret must be located in the namespace of the extension (default) or in the `garden` namespace.
+	// If not specified, the account is registered automatically.
+	// +optional
+	PrivateKeySecretRef *corev1.SecretReference `json:"privateKeySecretRef,omitempty"`
+	// ExternalAccountBinding is the external account binding of the ACME account.
+	// +optional
+	ExternalAccountBinding *ACMEExternalAccountBinding `json:"externalAccountBinding,omitempty"`
+	// SkipDNSChallengeValidation skips the DNS challenge validation.
+	// +optional
+	SkipDNSChallengeValidation *bool `json:"skipDNSChallengeValidation,omitempty"`
+	// PrecheckNameservers overwrites the default precheck nameservers used for checking DNS propagation.
+	// +optional
+	PrecheckNameservers []string `json:"precheckNameservers,omitempty"`
+	// Domains restricts the domains of certificate requests. The domains of an issuer in the shoot manifest
+	// referencing the template must be within the included domains.
+	// +optional
+	Domains *DNSSelection `json:"domains,omitempty"`
+}
+
+// ComplianceProfile is a named set of constraints for the issuance of certificates, e.g. for regulatory requirements.
+type ComplianceProfile struct {
+	// Name is the name of the profile.
+	Name string `json:"name"`
+	// SeedSelector binds the profile to all shoots of the seeds matching the label selector.
+	// +optional
+	SeedSelector *metav1.LabelSelector `json:"seedSelector,omitempty"`
+	// PrivateKeys restricts the algorithms and sizes of the private keys of certificates.
+	// +optional
+	PrivateKeys *PrivateKeyPolicy `json:"privateKeys,omitempty"`
+	// AllowedIssuerServers are the directory URLs of the allowed ACME servers. If not specified, all servers are allowed.
+	// Issuers on the shoot cluster are disabled if the servers are restricted.
+	// +optional
+	AllowedIssuerServers []string `json:"allowedIssuerServers,omitempty"`
+	// MaxCertificateDuration is the maximum lifetime of certificates requested in the shoot manifest.
+	// +optional
+	MaxCertificateDuration *metav1.Duration `json:"maxCertificateDuration,omitempty"`
+	// AllowSkipDNSChallengeValidation allows ACME issuers skipping the validation of DNS challenges.
+	// +optional
+	AllowSkipDNSChallengeValidation bool `json:"allowSkipDNSChallengeValidation,omitempty"`
+}
+
+// Proxy configures a proxy for the outgoing connections of the cert-controller-manager, e.g. to the ACME server.
+type Proxy struct {
+	// HTTPProxy is the proxy URL for HTTP requests.
+	// +optional
+	HTTPProxy *string `json:"httpProxy,omitempty"`
+	// HTTPSProxy is the proxy URL for HTTPS requests.
+	// +optional
+	HTTPSProxy *string `json:"httpsProxy,omitempty"`
+	// NoProxy is a comma-separated list of hosts, domains and CIDRs excluded from the proxy.
+	// +optional
+	NoProxy *string `json:"noProxy,omitempty"`
+}
+
+// ConfigurationOverride overrides parts of the configuration for the shoots of selected seeds.
+// A seed is selected if it matches all specified selectors.
+type ConfigurationOverride struct {
+	// Name is the name of the override.
+	Name string `json:"name"`
+	// SeedNames selects seeds by name.
+	// +optional
+	SeedNames []string `json:"seedNames,omitempty"`
+	// SeedSelector selects seeds by labels.
+	// +optional
+	SeedSelector *metav1.LabelSelector `json:"seedSelector,omitempty"`
+	// Regions selects seeds by provider region.
+	// +optional
+	Regions []string `json:"regions,omitempty"`
+	// ACME overrides the specified fields of the ACME configuration.
+	// +optional
+	ACME *ACME `json:"acme,omitempty"`
+	// Proxy overrides the specified fields of the proxy configuration.
+	// +optional
+	Proxy *Proxy `json:"proxy,omitempty"`
+}
+
+// ShootACMELimits contains the limits for the ACME settings in the shoot manifest.
+type ShootACMELimits struct {
+	// PropagationTimeout limits the timeout for DNS01 challenges.
+	// If no maximum is configured, the timeout is limited to 10m.
+	// +optional
+	PropagationTimeout *DurationLimits `json:"propagationTimeout,omitempty"`
+	// PrecheckAdditionalWait limits the additional wait time after the DNS propagation check.
+	// If no maximum is configured, the wait time is limited to 2m.
+	// +optional
+	PrecheckAdditionalWait *DurationLimits `json:"precheckAdditionalWait,omitempty"`
+}
+
+// DurationLimits contains the allowed range of a duration.
+type DurationLimits struct {
+	// Min is the minimum duration.
+	// +optional
+	Min *metav1.Duration `json:"min,omitempty"`
+	// Max is the maximum duration.
+	// +optional
+	Max *metav1.Duration `json:"max,omitempty"`
+}
+
 // ShootIssuers holds enablement for issuers on shoot cluster
 type ShootIssuers struct {
+	// Enabled is the enablement of issuers on shoot cluster if not overwritten in the shoot manifest.
 	Enabled bool `json:"enabled"`
+	// Policy controls if the enablement can be overwritten in the shoot manifest.
+	// Possible values are `Default`, `Forbid` and `Force`. Defaults to `Default`.
+	// +optional
+	Policy ShootIssuersPolicy `json:"policy,omitempty"`
 }
 
+// ShootIssuersPolicy controls if the enablement of issuers on shoot cluster can be overwritten in the shoot manifest.
+type ShootIssuersPolicy string
+
+const (
+	// ShootIssuersPolicyDefault uses the enablement of the service configuration as default, which can be overwritten in the shoot manifest.
+	ShootIssuersPolicyDefault ShootIssuersPolicy = "Default"
+	// ShootIssuersPolicyForbid disables issuers on shoot cluster for all shoots. Enabling them in the shoot manifest is rejected.
+	ShootIssuersPolicyForbid ShootIssuersPolicy = "Forbid"
+	// ShootIssuersPolicyForce enables issuers on shoot cluster for all shoots. Disabling them in the shoot manifest is rejected.
+	ShootIssuersPolicyForce ShootIssuersPolicy = "Force"
+)
+
 // ACME holds information about the ACME issuer used for the certificate service.
 type ACME struct {
 	// Email is the e-mail address used for the ACME issuer.
@@ -72,9 +300,16 @@ type ACME struct {
 	// PrivateKey is the key used for the ACME issuer.
 	// +optional
 	PrivateKey *string `json:"privateKey,omitempty"` // #nosec G117 -- false positive: optional field to provide private key for ACME issuer
+	// PrivateKeySecretRef references a secret containing the key used for the ACME issuer in the data key `privateKey`.
+	// The secret must be located in the namespace of the extension (default) or in the `garden` namespace.
+	// +optional
+	PrivateKeySecretRef *corev1.SecretReference `json:"privateKeySecretRef,omitempty"`
 	// PropagationTimeout is the timeout for DNS01 challenges.
 	// +optional
 	PropagationTimeout *metav1.Duration `json:"propagationTimeout,omitempty"`
+	// PrecheckAdditionalWait is the additional wait time after the DNS propagation check of DNS01 challenges.
+	// +optional
+	PrecheckAdditionalWait *metav1.Duration `json:"precheckAdditionalWait,omitempty"`
 	// PrecheckNameservers is used to specify a comma-separated list of DNS servers for checking availability for DNS
 	// challenge before calling ACME CA
 	// +optional
@@ -88,11 +323,51 @@ type ACME struct {
 	// SkipDNSChallengeValidation skips the DNS challenge validation
 	// +optional
 	SkipDNSChallengeValidation *bool `json:"skipDNSChallengeValidation,omitempty"`
+	// ExternalAccountBinding is the external account binding of the ACME account, required by some ACME servers.
+	// +optional
+	ExternalAccountBinding *ACMEExternalAccountBinding `json:"externalAccountBinding,omitempty"`
+	// Domains optionally specifies domains allowed or forbidden for certificate requests.
+	// +optional
+	Domains *DNSSelection `json:"domains,omitempty"`
+	// PreferredChain is the Subject Common Name of the issuer of the preferred certificate chain, if the ACME server offers
+	// alternate chains. It is the default for the certificates maintained by the extension in the shoot clusters using this issuer.
+	// +optional
+	PreferredChain *string `json:"preferredChain,omitempty"`
+	// KeyType is the type of the private key ('RSA2048', 'RSA3072', 'RSA4096', 'ECDSA256' or 'ECDSA384').
+	// It is the default for the certificates maintained by the extension in the shoot clusters using this issuer.
+	// +optional
+	KeyType *string `json:"keyType,omitempty"`
+}
+
+// ACMEExternalAccountBinding references the external account binding of the ACME account of the issuer.
+type ACMEExternalAccountBinding struct {
+	// KeyID is the ID of the CA key the external account is bound to.
+	KeyID string `json:"keyID"`
+	// KeySecretRef references a secret containing the base64url encoded MAC key of the external account in the data key `hmacKey`.
+	// The secret must be located in the namespace of the extension (default) or in the `garden` namespace.
+	KeySecretRef *corev1.SecretReference `json:"keySecretRef"`
+}
+
+// DNSSelection is a restriction on the domains to be allowed or forbidden for certificate requests.
+type DNSSelection struct {
+	// Include are domain names to be included (optional).
+	// +optional
+	Include []string `json:"include,omitempty"`
+	// Exclude are domain names to be excluded (optional).
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
 }
 
 type CA struct {
 	// Certificate is the public certificate of the CA in PEM format.
-	Certificate string `json:"certificate"`
+	// +optional
+	Certificate string `json:"certificate,omitempty"`
 	// CertificateKey is the private certificate key of the CA in PEM format.
-	CertificateKey string `json:"certificateKey"`
+	// +optional
+	CertificateKey string `json:"certificateKey,omitempty"`
+	// SecretRef references a secret of type `kubernetes.io/tls` containing the certificate and private key of the CA.
+	// The secret must be located in the namespace of the extension (default) or in the `garden` namespace.
+	// If specified, Certificate and CertificateKey must be empty.
+	// +optional
+	SecretRef *corev1.SecretReference `json:"secretRef,omitempty"`
 }
@@ -14,6 +14,7 @@ import (
 
 	config "github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
 	configv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -36,6 +37,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ACMEExternalAccountBinding)(nil), (*config.ACMEExternalAccountBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ACMEExternalAccountBinding_To_config_ACMEExternalAccountBinding(a.(*ACMEExternalAccountBinding), b.(*config.ACMEExternalAccountBinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ACMEExternalAccountBinding)(nil), (*ACMEExternalAccountBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountBinding(a.(*config.ACMEExternalAccountBinding), b.(*ACMEExternalAccountBinding), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CA)(nil), (*config.CA)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CA_To_config_CA(a.(*CA), b.(*config.CA), scope)
 	}); err != nil {
@@ -46,6 +57,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CatalogACME)(nil), (*config.CatalogACME)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CatalogACME_To_config_CatalogACME(a.(*CatalogACME), b.(*config.CatalogACME), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CatalogACME)(nil), (*CatalogACME)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CatalogACME_To_v1alpha1_CatalogACME(a.(*config.CatalogACME), b.(*CatalogACME), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CatalogIssuer)(nil), (*config.CatalogIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CatalogIssuer_To_config_CatalogIssuer(a.(*CatalogIssuer), b.(*config.CatalogIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CatalogIssuer)(nil), (*CatalogIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CatalogIssuer_To_v1alpha1_CatalogIssuer(a.(*config.CatalogIssuer), b.(*CatalogIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComplianceProfile)(nil), (*config.ComplianceProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComplianceProfile_To_config_ComplianceProfile(a.(*ComplianceProfile), b.(*config.ComplianceProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ComplianceProfile)(nil), (*ComplianceProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ComplianceProfile_To_v1alpha1_ComplianceProfile(a.(*config.ComplianceProfile), b.(*ComplianceProfile), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Configuration)(nil), (*config.Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Configuration_To_config_Configuration(a.(*Configuration), b.(*config.Configuration), scope)
 	}); err != nil {
@@ -56,6 +97,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ConfigurationOverride)(nil), (*config.ConfigurationOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ConfigurationOverride_To_config_ConfigurationOverride(a.(*ConfigurationOverride), b.(*config.ConfigurationOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ConfigurationOverride)(nil), (*ConfigurationOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ConfigurationOverride_To_v1alpha1_ConfigurationOverride(a.(*config.ConfigurationOverride), b.(*ConfigurationOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CustomIssuerPolicy)(nil), (*config.CustomIssuerPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CustomIssuerPolicy_To_config_CustomIssuerPolicy(a.(*CustomIssuerPolicy), b.(*config.CustomIssuerPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CustomIssuerPolicy)(nil), (*CustomIssuerPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CustomIssuerPolicy_To_v1alpha1_CustomIssuerPolicy(a.(*config.CustomIssuerPolicy), b.(*CustomIssuerPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSSelection)(nil), (*config.DNSSelection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSSelection_To_config_DNSSelection(a.(*DNSSelection), b.(*config.DNSSelection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DNSSelection)(nil), (*DNSSelection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DNSSelection_To_v1alpha1_DNSSelection(a.(*config.DNSSelection), b.(*DNSSelection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DefaultIssuerFallback)(nil), (*config.DefaultIssuerFallback)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DefaultIssuerFallback_To_config_DefaultIssuerFallback(a.(*DefaultIssuerFallback), b.(*config.DefaultIssuerFallback), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DefaultIssuerFallback)(nil), (*DefaultIssuerFallback)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DefaultIssuerFallback_To_v1alpha1_DefaultIssuerFallback(a.(*config.DefaultIssuerFallback), b.(*DefaultIssuerFallback), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DurationLimits)(nil), (*config.DurationLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DurationLimits_To_config_DurationLimits(a.(*DurationLimits), b.(*config.DurationLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DurationLimits)(nil), (*DurationLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DurationLimits_To_v1alpha1_DurationLimits(a.(*config.DurationLimits), b.(*DurationLimits), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PrivateKeyDefaults)(nil), (*config.PrivateKeyDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PrivateKeyDefaults_To_config_PrivateKeyDefaults(a.(*PrivateKeyDefaults), b.(*config.PrivateKeyDefaults), scope)
 	}); err != nil {
@@ -66,6 +157,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PrivateKeyPolicy)(nil), (*config.PrivateKeyPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrivateKeyPolicy_To_config_PrivateKeyPolicy(a.(*PrivateKeyPolicy), b.(*config.PrivateKeyPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PrivateKeyPolicy)(nil), (*PrivateKeyPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PrivateKeyPolicy_To_v1alpha1_PrivateKeyPolicy(a.(*config.PrivateKeyPolicy), b.(*PrivateKeyPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Proxy)(nil), (*config.Proxy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Proxy_To_config_Proxy(a.(*Proxy), b.(*config.Proxy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Proxy)(nil), (*Proxy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Proxy_To_v1alpha1_Proxy(a.(*config.Proxy), b.(*Proxy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootACMELimits)(nil), (*config.ShootACMELimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootACMELimits_To_config_ShootACMELimits(a.(*ShootACMELimits), b.(*config.ShootACMELimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootACMELimits)(nil), (*ShootACMELimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootACMELimits_To_v1alpha1_ShootACMELimits(a.(*config.ShootACMELimits), b.(*ShootACMELimits), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootIssuers)(nil), (*config.ShootIssuers)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootIssuers_To_config_ShootIssuers(a.(*ShootIssuers), b.(*config.ShootIssuers), scope)
 	}); err != nil {
@@ -83,11 +204,17 @@ func autoConvert_v1alpha1_ACME_To_config_ACME(in *ACME, out *config.ACME, s conv
 	out.Email = in.Email
 	out.Server = in.Server
 	out.PrivateKey = (*string)(unsafe.Pointer(in.PrivateKey))
+	out.PrivateKeySecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.PrivateKeySecretRef))
 	out.PropagationTimeout = (*v1.Duration)(unsafe.Pointer(in.PropagationTimeout))
+	out.PrecheckAdditionalWait = (*v1.Duration)(unsafe.Pointer(in.PrecheckAdditionalWait))
 	out.PrecheckNameservers = (*string)(unsafe.Pointer(in.PrecheckNameservers))
 	out.CACertificates = (*string)(unsafe.Pointer(in.CACertificates))
 	out.DeactivateAuthorizations = (*bool)(unsafe.Pointer(in.DeactivateAuthorizations))
 	out.SkipDNSChallengeValidation = (*bool)(unsafe.Pointer(in.SkipDNSChallengeValidation))
+	out.ExternalAccountBinding = (*config.ACMEExternalAccountBinding)(unsafe.Pointer(in.ExternalAccountBinding))
+	out.Domains = (*config.DNSSelection)(unsafe.Pointer(in.Domains))
+	out.PreferredChain = (*string)(unsafe.Pointer(in.PreferredChain))
+	out.KeyType = (*string)(unsafe.Pointer(in.KeyType))
 	return nil
 }
 
@@ -100,11 +227,17 @@ func autoConvert_config_ACME_To_v1alpha1_ACME(in *config.ACME, out *ACME, s conv
 	out.Email = in.Email
 	out.Server = in.Server
 	out.PrivateKey = (*string)(unsafe.Pointer(in.PrivateKey))
+	out.PrivateKeySecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.PrivateKeySecretRef))
 	out.PropagationTimeout = (*v1.Duration)(unsafe.Pointer(in.PropagationTimeout))
+	out.PrecheckAdditionalWait = (*v1.Duration)(unsafe.Pointer(in.PrecheckAdditionalWait))
 	out.PrecheckNameservers = (*string)(unsafe.Pointer(in.PrecheckNameservers))
 	out.CACertificates = (*string)(unsafe.Pointer(in.CACertificates))
 	out.DeactivateAuthorizations = (*bool)(unsafe.Pointer(in.DeactivateAuthorizations))
 	out.SkipDNSChallengeValidation = (*bool)(unsafe.Pointer(in.SkipDNSChallengeValidation))
+	out.ExternalAccountBinding = (*ACMEExternalAccountBinding)(unsafe.Pointer(in.ExternalAccountBinding))
+	out.Domains = (*DNSSelection)(unsafe.Pointer(in.Domains))
+	out.PreferredChain = (*string)(unsafe.Pointer(in.PreferredChain))
+	out.KeyType = (*string)(unsafe.Pointer(in.KeyType))
 	return nil
 }
 
@@ -113,9 +246,32 @@ func Convert_config_ACME_To_v1alpha1_ACME(in *config.ACME, out *ACME, s conversi
 	return autoConvert_config_ACME_To_v1alpha1_ACME(in, out, s)
 }
 
+func autoConvert_v1alpha1_ACMEExternalAccountBinding_To_config_ACMEExternalAccountBinding(in *ACMEExternalAccountBinding, out *config.ACMEExternalAccountBinding, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	out.KeySecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.KeySecretRef))
+	return nil
+}
+
+// Convert_v1alpha1_ACMEExternalAccountBinding_To_config_ACMEExternalAccountBinding is an autogenerated conversion function.
+func Convert_v1alpha1_ACMEExternalAccountBinding_To_config_ACMEExternalAccountBinding(in *ACMEExternalAccountBinding, out *config.ACMEExternalAccountBinding, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ACMEExternalAccountBinding_To_config_ACMEExternalAccountBinding(in, out, s)
+}
+
+func autoConvert_config_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountBinding(in *config.ACMEExternalAccountBinding, out *ACMEExternalAccountBinding, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	out.KeySecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.KeySecretRef))
+	return nil
+}
+
+// Convert_config_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountBinding is an autogenerated conversion function.
+func Convert_config_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountBinding(in *config.ACMEExternalAccountBinding, out *ACMEExternalAccountBinding, s conversion.Scope) error {
+	return autoConvert_config_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountBinding(in, out, s)
+}
+
 func autoConvert_v1alpha1_CA_To_config_CA(in *CA, out *config.CA, s conversion.Scope) error {
 	out.Certificate = in.Certificate
 	out.CertificateKey = in.CertificateKey
+	out.SecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.SecretRef))
 	return nil
 }
 
@@ -127,6 +283,7 @@ func Convert_v1alpha1_CA_To_config_CA(in *CA, out *config.CA, s conversion.Scope
 func autoConvert_config_CA_To_v1alpha1_CA(in *config.CA, out *CA, s conversion.Scope) error {
 	out.Certificate = in.Certificate
 	out.CertificateKey = in.CertificateKey
+	out.SecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.SecretRef))
 	return nil
 }
 
@@ -135,15 +292,116 @@ func Convert_config_CA_To_v1alpha1_CA(in *config.CA, out *CA, s conversion.Scope
 	return autoConvert_config_CA_To_v1alpha1_CA(in, out, s)
 }
 
+func autoConvert_v1alpha1_CatalogACME_To_config_CatalogACME(in *CatalogACME, out *config.CatalogACME, s conversion.Scope) error {
+	out.Email = in.Email
+	out.Server = in.Server
+	out.PrivateKeySecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.PrivateKeySecretRef))
+	out.ExternalAccountBinding = (*config.ACMEExternalAccountBinding)(unsafe.Pointer(in.ExternalAccountBinding))
+	out.SkipDNSChallengeValidation = (*bool)(unsafe.Pointer(in.SkipDNSChallengeValidation))
+	out.PrecheckNameservers = *(*[]string)(unsafe.Pointer(&in.PrecheckNameservers))
+	out.Domains = (*config.DNSSelection)(unsafe.Pointer(in.Domains))
+	return nil
+}
+
+// Convert_v1alpha1_CatalogACME_To_config_CatalogACME is an autogenerated conversion function.
+func Convert_v1alpha1_CatalogACME_To_config_CatalogACME(in *CatalogACME, out *config.CatalogACME, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CatalogACME_To_config_CatalogACME(in, out, s)
+}
+
+func autoConvert_config_CatalogACME_To_v1alpha1_CatalogACME(in *config.CatalogACME, out *CatalogACME, s conversion.Scope) error {
+	out.Email = in.Email
+	out.Server = in.Server
+	out.PrivateKeySecretRef = (*corev1.SecretReference)(unsafe.Pointer(in.PrivateKeySecretRef))
+	out.ExternalAccountBinding = (*ACMEExternalAccountBinding)(unsafe.Pointer(in.ExternalAccountBinding))
+	out.SkipDNSChallengeValidation = (*bool)(unsafe.Pointer(in.SkipDNSChallengeValidation))
+	out.PrecheckNameservers = *(*[]string)(unsafe.Pointer(&in.PrecheckNameservers))
+	out.Domains = (*DNSSelection)(unsafe.Pointer(in.Domains))
+	return nil
+}
+
+// Convert_config_CatalogACME_To_v1alpha1_CatalogACME is an autogenerated conversion function.
+func Convert_config_CatalogACME_To_v1alpha1_CatalogACME(in *config.CatalogACME, out *CatalogACME, s conversion.Scope) error {
+	return autoConvert_config_CatalogACME_To_v1alpha1_CatalogACME(in, out, s)
+}
+
+func autoConvert_v1alpha1_CatalogIssuer_To_config_CatalogIssuer(in *CatalogIssuer, out *config.CatalogIssuer, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.ACME = (*config.CatalogACME)(unsafe.Pointer(in.ACME))
+	out.CA = (*config.CA)(unsafe.Pointer(in.CA))
+	return nil
+}
+
+// Convert_v1alpha1_CatalogIssuer_To_config_CatalogIssuer is an autogenerated conversion function.
+func Convert_v1alpha1_CatalogIssuer_To_config_CatalogIssuer(in *CatalogIssuer, out *config.CatalogIssuer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CatalogIssuer_To_config_CatalogIssuer(in, out, s)
+}
+
+func autoConvert_config_CatalogIssuer_To_v1alpha1_CatalogIssuer(in *config.CatalogIssuer, out *CatalogIssuer, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.ACME = (*CatalogACME)(unsafe.Pointer(in.ACME))
+	out.CA = (*CA)(unsafe.Pointer(in.CA))
+	return nil
+}
+
+// Convert_config_CatalogIssuer_To_v1alpha1_CatalogIssuer is an autogenerated conversion function.
+func Convert_config_CatalogIssuer_To_v1alpha1_CatalogIssuer(in *config.CatalogIssuer, out *CatalogIssuer, s conversion.Scope) error {
+	return autoConvert_config_CatalogIssuer_To_v1alpha1_CatalogIssuer(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComplianceProfile_To_config_ComplianceProfile(in *ComplianceProfile, out *config.ComplianceProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.PrivateKeys = (*config.PrivateKeyPolicy)(unsafe.Pointer(in.PrivateKeys))
+	out.AllowedIssuerServers = *(*[]string)(unsafe.Pointer(&in.AllowedIssuerServers))
+	out.MaxCertificateDuration = (*v1.Duration)(unsafe.Pointer(in.MaxCertificateDuration))
+	out.AllowSkipDNSChallengeValidation = in.AllowSkipDNSChallengeValidation
+	return nil
+}
+
+// Convert_v1alpha1_ComplianceProfile_To_config_ComplianceProfile is an autogenerated conversion function.
+func Convert_v1alpha1_ComplianceProfile_To_config_ComplianceProfile(in *ComplianceProfile, out *config.ComplianceProfile, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComplianceProfile_To_config_ComplianceProfile(in, out, s)
+}
+
+func autoConvert_config_ComplianceProfile_To_v1alpha1_ComplianceProfile(in *config.ComplianceProfile, out *ComplianceProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.PrivateKeys = (*PrivateKeyPolicy)(unsafe.Pointer(in.PrivateKeys))
+	out.AllowedIssuerServers = *(*[]string)(unsafe.Pointer(&in.AllowedIssuerServers))
+	out.MaxCertificateDuration = (*v1.Duration)(unsafe.Pointer(in.MaxCertificateDuration))
+	out.AllowSkipDNSChallengeValidation = in.AllowSkipDNSChallengeValidation
+	return nil
+}
+
+// Convert_config_ComplianceProfile_To_v1alpha1_ComplianceProfile is an autogenerated conversion function.
+func Convert_config_ComplianceProfile_To_v1alpha1_ComplianceProfile(in *config.ComplianceProfile, out *ComplianceProfile, s conversion.Scope) error {
+	return autoConvert_config_ComplianceProfile_To_v1alpha1_ComplianceProfile(in, out, s)
+}
+
 func autoConvert_v1alpha1_Configuration_To_config_Configuration(in *Configuration, out *config.Configuration, s conversion.Scope) error {
 	out.IssuerName = in.IssuerName
 	out.RestrictIssuer = (*bool)(unsafe.Pointer(in.RestrictIssuer))
+	out.RestrictedDomainsFromDNSProviders = (*bool)(unsafe.Pointer(in.RestrictedDomainsFromDNSProviders))
 	out.DefaultRequestsPerDayQuota = (*int32)(unsafe.Pointer(in.DefaultRequestsPerDayQuota))
 	out.ShootIssuers = (*config.ShootIssuers)(unsafe.Pointer(in.ShootIssuers))
+	out.AllowDisableGardenIssuer = (*bool)(unsafe.Pointer(in.AllowDisableGardenIssuer))
 	out.ACME = (*config.ACME)(unsafe.Pointer(in.ACME))
+	out.ShootACMELimits = (*config.ShootACMELimits)(unsafe.Pointer(in.ShootACMELimits))
 	out.CA = (*config.CA)(unsafe.Pointer(in.CA))
 	out.HealthCheckConfig = (*configv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.PrivateKeyDefaults = (*config.PrivateKeyDefaults)(unsafe.Pointer(in.PrivateKeyDefaults))
+	out.PrivateKeyPolicy = (*config.PrivateKeyPolicy)(unsafe.Pointer(in.PrivateKeyPolicy))
+	out.CustomIssuerPolicy = (*config.CustomIssuerPolicy)(unsafe.Pointer(in.CustomIssuerPolicy))
+	out.IssuerCatalog = *(*[]config.CatalogIssuer)(unsafe.Pointer(&in.IssuerCatalog))
+	out.DefaultIssuerFallback = (*config.DefaultIssuerFallback)(unsafe.Pointer(in.DefaultIssuerFallback))
+	out.ComplianceProfiles = *(*[]config.ComplianceProfile)(unsafe.Pointer(&in.ComplianceProfiles))
+	out.DefaultComplianceProfile = (*string)(unsafe.Pointer(in.DefaultComplianceProfile))
+	out.Proxy = (*config.Proxy)(unsafe.Pointer(in.Proxy))
+	out.Overrides = *(*[]config.ConfigurationOverride)(unsafe.Pointer(&in.Overrides))
 	out.InClusterACMEServerNamespaceMatchLabel = *(*map[string]string)(unsafe.Pointer(&in.InClusterACMEServerNamespaceMatchLabel))
 	return nil
 }
@@ -156,12 +414,23 @@ func Convert_v1alpha1_Configuration_To_config_Configuration(in *Configuration, o
 func autoConvert_config_Configuration_To_v1alpha1_Configuration(in *config.Configuration, out *Configuration, s conversion.Scope) error {
 	out.IssuerName = in.IssuerName
 	out.RestrictIssuer = (*bool)(unsafe.Pointer(in.RestrictIssuer))
+	out.RestrictedDomainsFromDNSProviders = (*bool)(unsafe.Pointer(in.RestrictedDomainsFromDNSProviders))
 	out.DefaultRequestsPerDayQuota = (*int32)(unsafe.Pointer(in.DefaultRequestsPerDayQuota))
 	out.ShootIssuers = (*ShootIssuers)(unsafe.Pointer(in.ShootIssuers))
+	out.AllowDisableGardenIssuer = (*bool)(unsafe.Pointer(in.AllowDisableGardenIssuer))
 	out.ACME = (*ACME)(unsafe.Pointer(in.ACME))
+	out.ShootACMELimits = (*ShootACMELimits)(unsafe.Pointer(in.ShootACMELimits))
 	out.CA = (*CA)(unsafe.Pointer(in.CA))
 	out.HealthCheckConfig = (*configv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.PrivateKeyDefaults = (*PrivateKeyDefaults)(unsafe.Pointer(in.PrivateKeyDefaults))
+	out.PrivateKeyPolicy = (*PrivateKeyPolicy)(unsafe.Pointer(in.PrivateKeyPolicy))
+	out.CustomIssuerPolicy = (*CustomIssuerPolicy)(unsafe.Pointer(in.CustomIssuerPolicy))
+	out.IssuerCatalog = *(*[]CatalogIssuer)(unsafe.Pointer(&in.IssuerCatalog))
+	out.DefaultIssuerFallback = (*DefaultIssuerFallback)(unsafe.Pointer(in.DefaultIssuerFallback))
+	out.ComplianceProfiles = *(*[]ComplianceProfile)(unsafe.Pointer(&in.ComplianceProfiles))
+	out.DefaultComplianceProfile = (*string)(unsafe.Pointer(in.DefaultComplianceProfile))
+	out.Proxy = (*Proxy)(unsafe.Pointer(in.Proxy))
+	out.Overrides = *(*[]ConfigurationOverride)(unsafe.Pointer(&in.Overrides))
 	out.InClusterACMEServerNamespaceMatchLabel = *(*map[string]string)(unsafe.Pointer(&in.InClusterACMEServerNamespaceMatchLabel))
 	return nil
 }
@@ -171,6 +440,132 @@ func Convert_config_Configuration_To_v1alpha1_Configuration(in *config.Configura
 	return autoConvert_config_Configuration_To_v1alpha1_Configuration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ConfigurationOverride_To_config_ConfigurationOverride(in *ConfigurationOverride, out *config.ConfigurationOverride, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SeedNames = *(*[]string)(unsafe.Pointer(&in.SeedNames))
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.Regions = *(*[]string)(unsafe.Pointer(&in.Regions))
+	out.ACME = (*config.ACME)(unsafe.Pointer(in.ACME))
+	out.Proxy = (*config.Proxy)(unsafe.Pointer(in.Proxy))
+	return nil
+}
+
+// Convert_v1alpha1_ConfigurationOverride_To_config_ConfigurationOverride is an autogenerated conversion function.
+func Convert_v1alpha1_ConfigurationOverride_To_config_ConfigurationOverride(in *ConfigurationOverride, out *config.ConfigurationOverride, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ConfigurationOverride_To_config_ConfigurationOverride(in, out, s)
+}
+
+func autoConvert_config_ConfigurationOverride_To_v1alpha1_ConfigurationOverride(in *config.ConfigurationOverride, out *ConfigurationOverride, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SeedNames = *(*[]string)(unsafe.Pointer(&in.SeedNames))
+	out.SeedSelector = (*v1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	out.Regions = *(*[]string)(unsafe.Pointer(&in.Regions))
+	out.ACME = (*ACME)(unsafe.Pointer(in.ACME))
+	out.Proxy = (*Proxy)(unsafe.Pointer(in.Proxy))
+	return nil
+}
+
+// Convert_config_ConfigurationOverride_To_v1alpha1_ConfigurationOverride is an autogenerated conversion function.
+func Convert_config_ConfigurationOverride_To_v1alpha1_ConfigurationOverride(in *config.ConfigurationOverride, out *ConfigurationOverride, s conversion.Scope) error {
+	return autoConvert_config_ConfigurationOverride_To_v1alpha1_ConfigurationOverride(in, out, s)
+}
+
+func autoConvert_v1alpha1_CustomIssuerPolicy_To_config_CustomIssuerPolicy(in *CustomIssuerPolicy, out *config.CustomIssuerPolicy, s conversion.Scope) error {
+	out.AllowedServers = *(*[]string)(unsafe.Pointer(&in.AllowedServers))
+	out.DeniedServers = *(*[]string)(unsafe.Pointer(&in.DeniedServers))
+	out.MaxIssuers = (*int)(unsafe.Pointer(in.MaxIssuers))
+	out.ExternalAccountBindingRequiredServers = *(*[]string)(unsafe.Pointer(&in.ExternalAccountBindingRequiredServers))
+	out.RestrictPrecheckNameservers = in.RestrictPrecheckNameservers
+	out.ApprovedPrecheckNameservers = *(*[]string)(unsafe.Pointer(&in.ApprovedPrecheckNameservers))
+	return nil
+}
+
+// Convert_v1alpha1_CustomIssuerPolicy_To_config_CustomIssuerPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_CustomIssuerPolicy_To_config_CustomIssuerPolicy(in *CustomIssuerPolicy, out *config.CustomIssuerPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CustomIssuerPolicy_To_config_CustomIssuerPolicy(in, out, s)
+}
+
+func autoConvert_config_CustomIssuerPolicy_To_v1alpha1_CustomIssuerPolicy(in *config.CustomIssuerPolicy, out *CustomIssuerPolicy, s conversion.Scope) error {
+	out.AllowedServers = *(*[]string)(unsafe.Pointer(&in.AllowedServers))
+	out.DeniedServers = *(*[]string)(unsafe.Pointer(&in.DeniedServers))
+	out.MaxIssuers = (*int)(unsafe.Pointer(in.MaxIssuers))
+	out.ExternalAccountBindingRequiredServers = *(*[]string)(unsafe.Pointer(&in.ExternalAccountBindingRequiredServers))
+	out.RestrictPrecheckNameservers = in.RestrictPrecheckNameservers
+	out.ApprovedPrecheckNameservers = *(*[]string)(unsafe.Pointer(&in.ApprovedPrecheckNameservers))
+	return nil
+}
+
+// Convert_config_CustomIssuerPolicy_To_v1alpha1_CustomIssuerPolicy is an autogenerated conversion function.
+func Convert_config_CustomIssuerPolicy_To_v1alpha1_CustomIssuerPolicy(in *config.CustomIssuerPolicy, out *CustomIssuerPolicy, s conversion.Scope) error {
+	return autoConvert_config_CustomIssuerPolicy_To_v1alpha1_CustomIssuerPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSSelection_To_config_DNSSelection(in *DNSSelection, out *config.DNSSelection, s conversion.Scope) error {
+	out.Include = *(*[]string)(unsafe.Pointer(&in.Include))
+	out.Exclude = *(*[]string)(unsafe.Pointer(&in.Exclude))
+	return nil
+}
+
+// Convert_v1alpha1_DNSSelection_To_config_DNSSelection is an autogenerated conversion function.
+func Convert_v1alpha1_DNSSelection_To_config_DNSSelection(in *DNSSelection, out *config.DNSSelection, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSSelection_To_config_DNSSelection(in, out, s)
+}
+
+func autoConvert_config_DNSSelection_To_v1alpha1_DNSSelection(in *config.DNSSelection, out *DNSSelection, s conversion.Scope) error {
+	out.Include = *(*[]string)(unsafe.Pointer(&in.Include))
+	out.Exclude = *(*[]string)(unsafe.Pointer(&in.Exclude))
+	return nil
+}
+
+// Convert_config_DNSSelection_To_v1alpha1_DNSSelection is an autogenerated conversion function.
+func Convert_config_DNSSelection_To_v1alpha1_DNSSelection(in *config.DNSSelection, out *DNSSelection, s conversion.Scope) error {
+	return autoConvert_config_DNSSelection_To_v1alpha1_DNSSelection(in, out, s)
+}
+
+func autoConvert_v1alpha1_DefaultIssuerFallback_To_config_DefaultIssuerFallback(in *DefaultIssuerFallback, out *config.DefaultIssuerFallback, s conversion.Scope) error {
+	out.Issuers = *(*[]config.CatalogIssuer)(unsafe.Pointer(&in.Issuers))
+	out.MinHoldDuration = (*v1.Duration)(unsafe.Pointer(in.MinHoldDuration))
+	return nil
+}
+
+// Convert_v1alpha1_DefaultIssuerFallback_To_config_DefaultIssuerFallback is an autogenerated conversion function.
+func Convert_v1alpha1_DefaultIssuerFallback_To_config_DefaultIssuerFallback(in *DefaultIssuerFallback, out *config.DefaultIssuerFallback, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DefaultIssuerFallback_To_config_DefaultIssuerFallback(in, out, s)
+}
+
+func autoConvert_config_DefaultIssuerFallback_To_v1alpha1_DefaultIssuerFallback(in *config.DefaultIssuerFallback, out *DefaultIssuerFallback, s conversion.Scope) error {
+	out.Issuers = *(*[]CatalogIssuer)(unsafe.Pointer(&in.Issuers))
+	out.MinHoldDuration = (*v1.Duration)(unsafe.Pointer(in.MinHoldDuration))
+	return nil
+}
+
+// Convert_config_DefaultIssuerFallback_To_v1alpha1_DefaultIssuerFallback is an autogenerated conversion function.
+func Convert_config_DefaultIssuerFallback_To_v1alpha1_DefaultIssuerFallback(in *config.DefaultIssuerFallback, out *DefaultIssuerFallback, s conversion.Scope) error {
+	return autoConvert_config_DefaultIssuerFallback_To_v1alpha1_DefaultIssuerFallback(in, out, s)
+}
+
+func autoConvert_v1alpha1_DurationLimits_To_config_DurationLimits(in *DurationLimits, out *config.DurationLimits, s conversion.Scope) error {
+	out.Min = (*v1.Duration)(unsafe.Pointer(in.Min))
+	out.Max = (*v1.Duration)(unsafe.Pointer(in.Max))
+	return nil
+}
+
+// Convert_v1alpha1_DurationLimits_To_config_DurationLimits is an autogenerated conversion function.
+func Convert_v1alpha1_DurationLimits_To_config_DurationLimits(in *DurationLimits, out *config.DurationLimits, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DurationLimits_To_config_DurationLimits(in, out, s)
+}
+
+func autoConvert_config_DurationLimits_To_v1alpha1_DurationLimits(in *config.DurationLimits, out *DurationLimits, s conversion.Scope) error {
+	out.Min = (*v1.Duration)(unsafe.Pointer(in.Min))
+	out.Max = (*v1.Duration)(unsafe.Pointer(in.Max))
+	return nil
+}
+
+// Convert_config_DurationLimits_To_v1alpha1_DurationLimits is an autogenerated conversion function.
+func Convert_config_DurationLimits_To_v1alpha1_DurationLimits(in *config.DurationLimits, out *DurationLimits, s conversion.Scope) error {
+	return autoConvert_config_DurationLimits_To_v1alpha1_DurationLimits(in, out, s)
+}
+
 func autoConvert_v1alpha1_PrivateKeyDefaults_To_config_PrivateKeyDefaults(in *PrivateKeyDefaults, out *config.PrivateKeyDefaults, s conversion.Scope) error {
 	out.Algorithm = (*string)(unsafe.Pointer(in.Algorithm))
 	out.SizeRSA = (*int)(unsafe.Pointer(in.SizeRSA))
@@ -195,8 +590,79 @@ func Convert_config_PrivateKeyDefaults_To_v1alpha1_PrivateKeyDefaults(in *config
 	return autoConvert_config_PrivateKeyDefaults_To_v1alpha1_PrivateKeyDefaults(in, out, s)
 }
 
+func autoConvert_v1alpha1_PrivateKeyPolicy_To_config_PrivateKeyPolicy(in *PrivateKeyPolicy, out *config.PrivateKeyPolicy, s conversion.Scope) error {
+	out.AllowedAlgorithms = *(*[]string)(unsafe.Pointer(&in.AllowedAlgorithms))
+	out.MinSizeRSA = (*int)(unsafe.Pointer(in.MinSizeRSA))
+	out.MinSizeECDSA = (*int)(unsafe.Pointer(in.MinSizeECDSA))
+	return nil
+}
+
+// Convert_v1alpha1_PrivateKeyPolicy_To_config_PrivateKeyPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_PrivateKeyPolicy_To_config_PrivateKeyPolicy(in *PrivateKeyPolicy, out *config.PrivateKeyPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrivateKeyPolicy_To_config_PrivateKeyPolicy(in, out, s)
+}
+
+func autoConvert_config_PrivateKeyPolicy_To_v1alpha1_PrivateKeyPolicy(in *config.PrivateKeyPolicy, out *PrivateKeyPolicy, s conversion.Scope) error {
+	out.AllowedAlgorithms = *(*[]string)(unsafe.Pointer(&in.AllowedAlgorithms))
+	out.MinSizeRSA = (*int)(unsafe.Pointer(in.MinSizeRSA))
+	out.MinSizeECDSA = (*int)(unsafe.Pointer(in.MinSizeECDSA))
+	return nil
+}
+
+// Convert_config_PrivateKeyPolicy_To_v1alpha1_PrivateKeyPolicy is an autogenerated conversion function.
+func Convert_config_PrivateKeyPolicy_To_v1alpha1_PrivateKeyPolicy(in *config.PrivateKeyPolicy, out *PrivateKeyPolicy, s conversion.Scope) error {
+	return autoConvert_config_PrivateKeyPolicy_To_v1alpha1_PrivateKeyPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_Proxy_To_config_Proxy(in *Proxy, out *config.Proxy, s conversion.Scope) error {
+	out.HTTPProxy = (*string)(unsafe.Pointer(in.HTTPProxy))
+	out.HTTPSProxy = (*string)(unsafe.Pointer(in.HTTPSProxy))
+	out.NoProxy = (*string)(unsafe.Pointer(in.NoProxy))
+	return nil
+}
+
+// Convert_v1alpha1_Proxy_To_config_Proxy is an autogenerated conversion function.
+func Convert_v1alpha1_Proxy_To_config_Proxy(in *Proxy, out *config.Proxy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Proxy_To_config_Proxy(in, out, s)
+}
+
+func autoConvert_config_Proxy_To_v1alpha1_Proxy(in *config.Proxy, out *Proxy, s conversion.Scope) error {
+	out.HTTPProxy = (*string)(unsafe.Pointer(in.HTTPProxy))
+	out.HTTPSProxy = (*string)(unsafe.Pointer(in.HTTPSProxy))
+	out.NoProxy = (*string)(unsafe.Pointer(in.NoProxy))
+	return nil
+}
+
+// Convert_config_Proxy_To_v1alpha1_Proxy is an autogenerated conversion function.
+func Convert_config_Proxy_To_v1alpha1_Proxy(in *config.Proxy, out *Proxy, s conversion.Scope) error {
+	return autoConvert_config_Proxy_To_v1alpha1_Proxy(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootACMELimits_To_config_ShootACMELimits(in *ShootACMELimits, out *config.ShootACMELimits, s conversion.Scope) error {
+	out.PropagationTimeout = (*config.DurationLimits)(unsafe.Pointer(in.PropagationTimeout))
+	out.PrecheckAdditionalWait = (*config.DurationLimits)(unsafe.Pointer(in.PrecheckAdditionalWait))
+	return nil
+}
+
+// Convert_v1alpha1_ShootACMELimits_To_config_ShootACMELimits is an autogenerated conversion function.
+func Convert_v1alpha1_ShootACMELimits_To_config_ShootACMELimits(in *ShootACMELimits, out *config.ShootACMELimits, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootACMELimits_To_config_ShootACMELimits(in, out, s)
+}
+
+func autoConvert_config_ShootACMELimits_To_v1alpha1_ShootACMELimits(in *config.ShootACMELimits, out *ShootACMELimits, s conversion.Scope) error {
+	out.PropagationTimeout = (*DurationLimits)(unsafe.Pointer(in.PropagationTimeout))
+	out.PrecheckAdditionalWait = (*DurationLimits)(unsafe.Pointer(in.PrecheckAdditionalWait))
+	return nil
+}
+
+// Convert_config_ShootACMELimits_To_v1alpha1_ShootACMELimits is an autogenerated conversion function.
+func Convert_config_ShootACMELimits_To_v1alpha1_ShootACMELimits(in *config.ShootACMELimits, out *ShootACMELimits, s conversion.Scope) error {
+	return autoConvert_config_ShootACMELimits_To_v1alpha1_ShootACMELimits(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootIssuers_To_config_ShootIssuers(in *ShootIssuers, out *config.ShootIssuers, s conversion.Scope) error {
 	out.Enabled = in.Enabled
+	out.Policy = config.ShootIssuersPolicy(in.Policy)
 	return nil
 }
 
@@ -207,6 +673,7 @@ func Convert_v1alpha1_ShootIssuers_To_config_ShootIssuers(in *ShootIssuers, out
 
 func autoConvert_config_ShootIssuers_To_v1alpha1_ShootIssuers(in *config.ShootIssuers, out *ShootIssuers, s conversion.Scope) error {
 	out.Enabled = in.Enabled
+	out.Policy = ShootIssuersPolicy(in.Policy)
 	return nil
 }
 
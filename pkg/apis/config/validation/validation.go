@@ -10,12 +10,18 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
 )
@@ -46,6 +52,82 @@ func ValidateConfiguration(config *config.Configuration) field.ErrorList {
 	}
 
 	allErrs = append(allErrs, validatePrivateKeyDefaults(config.PrivateKeyDefaults, field.NewPath("privateKeyDefaults"))...)
+	allErrs = append(allErrs, validatePrivateKeyPolicy(config.PrivateKeyPolicy, config.PrivateKeyDefaults, field.NewPath("privateKeyPolicy"))...)
+	if config.ACME != nil && config.ACME.KeyType != nil && config.PrivateKeyPolicy != nil {
+		if err := ValidateKeyType(field.NewPath("acme", "keyType"), *config.ACME.KeyType, config.PrivateKeyPolicy); err != nil && err.Type != field.ErrorTypeNotSupported {
+			allErrs = append(allErrs, err)
+		}
+	}
+
+	allErrs = append(allErrs, validateCustomIssuerPolicy(config.CustomIssuerPolicy, config.ShootIssuers, field.NewPath("customIssuerPolicy"))...)
+
+	allErrs = append(allErrs, validateIssuerCatalog(config, field.NewPath("issuerCatalog"))...)
+
+	allErrs = append(allErrs, validateDefaultIssuerFallback(config, field.NewPath("defaultIssuerFallback"))...)
+
+	allErrs = append(allErrs, validateComplianceProfiles(config, field.NewPath("complianceProfiles"))...)
+
+	allErrs = append(allErrs, validateProxy(config.Proxy, field.NewPath("proxy"))...)
+
+	allErrs = append(allErrs, validateConfigurationOverrides(config, field.NewPath("overrides"))...)
+
+	allErrs = append(allErrs, validateShootIssuers(config.ShootIssuers, field.NewPath("shootIssuers"))...)
+
+	allErrs = append(allErrs, validateShootACMELimits(config.ShootACMELimits, field.NewPath("shootACMELimits"))...)
+
+	return allErrs
+}
+
+func validateShootACMELimits(limits *config.ShootACMELimits, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if limits == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateDurationLimits(limits.PropagationTimeout, fldPath.Child("propagationTimeout"))...)
+	allErrs = append(allErrs, validateDurationLimits(limits.PrecheckAdditionalWait, fldPath.Child("precheckAdditionalWait"))...)
+
+	return allErrs
+}
+
+func validateDurationLimits(limits *config.DurationLimits, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if limits == nil {
+		return allErrs
+	}
+
+	if limits.Min != nil && limits.Min.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("min"), limits.Min.Duration.String(), "must not be negative"))
+	}
+	if limits.Max != nil && limits.Max.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("max"), limits.Max.Duration.String(), "must be positive"))
+	}
+	if limits.Min != nil && limits.Max != nil && limits.Min.Duration > limits.Max.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("max"), limits.Max.Duration.String(), "must not be less than min"))
+	}
+
+	return allErrs
+}
+
+func validateShootIssuers(shootIssuers *config.ShootIssuers, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if shootIssuers == nil {
+		return allErrs
+	}
+
+	switch shootIssuers.Policy {
+	case "", config.ShootIssuersPolicyDefault, config.ShootIssuersPolicyForce:
+	case config.ShootIssuersPolicyForbid:
+		if shootIssuers.Enabled {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("enabled"), shootIssuers.Enabled, "must be false for policy Forbid"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("policy"), shootIssuers.Policy,
+			[]config.ShootIssuersPolicy{config.ShootIssuersPolicyDefault, config.ShootIssuersPolicyForbid, config.ShootIssuersPolicyForce}))
+	}
 
 	return allErrs
 }
@@ -61,6 +143,13 @@ func validateACME(acme *config.ACME, fldPath *field.Path) field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("email"), acme.Email, "must be a valid mail address"))
 	}
 
+	allErrs = append(allErrs, validateACMEOptionalFields(acme, fldPath)...)
+	return allErrs
+}
+
+func validateACMEOptionalFields(acme *config.ACME, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
 	if acme.PrecheckNameservers != nil {
 		servers := strings.Split(*acme.PrecheckNameservers, ",")
 		if len(servers) == 1 && len(servers[0]) == 0 {
@@ -75,13 +164,76 @@ func validateACME(acme *config.ACME, fldPath *field.Path) field.ErrorList {
 	}
 
 	if acme.CACertificates != nil {
-		if err := validateCACertificates(fldPath.Child("caCertificates"), *acme.CACertificates); err != nil {
+		if err := ValidateCACertificates(fldPath.Child("caCertificates"), *acme.CACertificates); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+
+	if acme.PrivateKeySecretRef != nil {
+		if acme.PrivateKey != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("privateKeySecretRef"), "must not be specified together with privateKey"))
+		}
+		allErrs = append(allErrs, validateSecretReference(acme.PrivateKeySecretRef, fldPath.Child("privateKeySecretRef"))...)
+	}
+
+	if eab := acme.ExternalAccountBinding; eab != nil {
+		eabPath := fldPath.Child("externalAccountBinding")
+		if eab.KeyID == "" {
+			allErrs = append(allErrs, field.Required(eabPath.Child("keyID"), "must not be empty"))
+		}
+		if eab.KeySecretRef == nil {
+			allErrs = append(allErrs, field.Required(eabPath.Child("keySecretRef"), "must be specified"))
+		} else {
+			allErrs = append(allErrs, validateSecretReference(eab.KeySecretRef, eabPath.Child("keySecretRef"))...)
+		}
+	}
+
+	if acme.Domains != nil {
+		allErrs = append(allErrs, validateDomains(acme.Domains.Include, fldPath.Child("domains", "include"))...)
+		allErrs = append(allErrs, validateDomains(acme.Domains.Exclude, fldPath.Child("domains", "exclude"))...)
+	}
+
+	if acme.PreferredChain != nil {
+		if err := ValidatePreferredChain(fldPath.Child("preferredChain"), *acme.PreferredChain); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+	if acme.KeyType != nil {
+		if err := ValidateKeyType(fldPath.Child("keyType"), *acme.KeyType); err != nil {
 			allErrs = append(allErrs, err)
 		}
 	}
 	return allErrs
 }
 
+func validateDomains(domains []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, domain := range domains {
+		for _, msg := range k8svalidation.IsDNS1123Subdomain(strings.ToLower(domain)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), domain, msg))
+		}
+	}
+	return allErrs
+}
+
+// validateSecretReference validates a reference to a secret in the namespace of the extension or in the garden namespace.
+func validateSecretReference(ref *corev1.SecretReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ref.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "name of the secret is required"))
+	} else {
+		for _, msg := range k8svalidation.IsDNS1123Subdomain(ref.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), ref.Name, msg))
+		}
+	}
+	if ref.Namespace != "" && ref.Namespace != v1beta1constants.GardenNamespace {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), ref.Namespace,
+			fmt.Sprintf("secret must be located in the namespace of the extension (empty) or in the %q namespace", v1beta1constants.GardenNamespace)))
+	}
+	return allErrs
+}
+
 // ValidateNameserver validates a nameserver address, accepting an IP address or domain name with an optional port (host:port format).
 func ValidateNameserver(server string) error {
 	host, port, err := net.SplitHostPort(server)
@@ -118,9 +270,40 @@ func ValidateNameserver(server string) error {
 	return nil
 }
 
+// IsApprovedNameserver checks if the nameserver is one of the approved nameservers.
+// Nameservers without port are compared with the default DNS port 53.
+func IsApprovedNameserver(server string, approved []string) bool {
+	server = normalizeNameserver(server)
+	for _, a := range approved {
+		if normalizeNameserver(a) == server {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeNameserver(server string) string {
+	server = strings.TrimSpace(server)
+	host, port, err := net.SplitHostPort(server)
+	if err != nil {
+		host, port = strings.TrimSuffix(strings.TrimPrefix(server, "["), "]"), "53"
+	}
+	return net.JoinHostPort(strings.ToLower(strings.TrimSuffix(host, ".")), port)
+}
+
 func validateCA(ca *config.CA, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	if ca.SecretRef != nil {
+		if ca.Certificate != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("certificate"), "must not be specified together with secretRef"))
+		}
+		if ca.CertificateKey != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("certificateKey"), "must not be specified together with secretRef"))
+		}
+		return append(allErrs, validateSecretReference(ca.SecretRef, fldPath.Child("secretRef"))...)
+	}
+
 	if _, err := validateCertificate(fldPath.Child("certificate"), []byte(strings.TrimSpace(ca.Certificate))); err != nil {
 		allErrs = append(allErrs, err)
 	}
@@ -131,7 +314,46 @@ func validateCA(ca *config.CA, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
-func validateCACertificates(fldPath *field.Path, caCertificates string) *field.Error {
+// ValidatePreferredChain validates the Subject Common Name of the issuer of a preferred certificate chain.
+func ValidatePreferredChain(fldPath *field.Path, preferredChain string) *field.Error {
+	switch {
+	case strings.TrimSpace(preferredChain) == "":
+		return field.Invalid(fldPath, preferredChain, "must not be empty")
+	case len(preferredChain) > 64:
+		return field.TooLong(fldPath, preferredChain, 64)
+	}
+	return nil
+}
+
+// ValidateKeyType validates the key type of an issuer and checks it against the private key policies.
+func ValidateKeyType(fldPath *field.Path, keyType string, policies ...*config.PrivateKeyPolicy) *field.Error {
+	algorithm, size, err := ParseKeyType(keyType)
+	if err != nil {
+		return field.NotSupported(fldPath, keyType, keyTypes)
+	}
+	if msg := PrivateKeyPolicyViolation(algorithm, size, policies...); msg != "" {
+		return field.Invalid(fldPath, keyType, msg)
+	}
+	return nil
+}
+
+var keyTypes = []string{"RSA2048", "RSA3072", "RSA4096", "ECDSA256", "ECDSA384"}
+
+// ParseKeyType returns the algorithm and the size of the private key of the given key type.
+func ParseKeyType(keyType string) (string, int, error) {
+	if !slices.Contains(keyTypes, keyType) {
+		return "", 0, fmt.Errorf("unsupported key type %q", keyType)
+	}
+	algorithm := strings.TrimRight(keyType, "0123456789")
+	size, err := strconv.Atoi(strings.TrimPrefix(keyType, algorithm))
+	if err != nil {
+		return "", 0, err
+	}
+	return algorithm, size, nil
+}
+
+// ValidateCACertificates validates a bundle of certificates in PEM format.
+func ValidateCACertificates(fldPath *field.Path, caCertificates string) *field.Error {
 	data := []byte(strings.TrimSpace(caCertificates))
 	for len(data) > 0 {
 		var err *field.Error
@@ -203,6 +425,428 @@ func validatePrivateKeyDefaults(defaults *config.PrivateKeyDefaults, fldPath *fi
 	return allErrs
 }
 
+func validatePrivateKeyPolicy(policy *config.PrivateKeyPolicy, defaults *config.PrivateKeyDefaults, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if policy == nil {
+		return allErrs
+	}
+
+	algorithms := map[string]bool{}
+	for i, algorithm := range policy.AllowedAlgorithms {
+		switch {
+		case algorithm != "RSA" && algorithm != "ECDSA":
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("allowedAlgorithms").Index(i), algorithm, []string{"RSA", "ECDSA"}))
+		case algorithms[algorithm]:
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("allowedAlgorithms").Index(i), algorithm))
+		}
+		algorithms[algorithm] = true
+	}
+	if policy.MinSizeRSA != nil && *policy.MinSizeRSA != 2048 && *policy.MinSizeRSA != 3072 && *policy.MinSizeRSA != 4096 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minSizeRSA"), *policy.MinSizeRSA, "size for RSA algorithm must either be '2048' or '3072' or '4096'"))
+	}
+	if policy.MinSizeECDSA != nil && *policy.MinSizeECDSA != 256 && *policy.MinSizeECDSA != 384 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minSizeECDSA"), *policy.MinSizeECDSA, "size for ECDSA algorithm must either be '256' or '384'"))
+	}
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	// the operator defaults are used for all shoots without own defaults, so they must comply with the policy
+	algorithm, sizeRSA, sizeECDSA := config.DefaultPrivateKeyAlgorithm, config.DefaultPrivateKeySizeRSA, config.DefaultPrivateKeySizeECDSA
+	if defaults != nil {
+		algorithm = ptr.Deref(defaults.Algorithm, algorithm)
+		sizeRSA = ptr.Deref(defaults.SizeRSA, sizeRSA)
+		sizeECDSA = ptr.Deref(defaults.SizeECDSA, sizeECDSA)
+	}
+	defaultsPath := field.NewPath("privateKeyDefaults")
+	if msg := PrivateKeyPolicyViolation(algorithm, 0, policy); msg != "" {
+		allErrs = append(allErrs, field.Invalid(defaultsPath.Child("algorithm"), algorithm, msg))
+	}
+	if IsPrivateKeyAlgorithmAllowed(policy, "RSA") {
+		if msg := PrivateKeyPolicyViolation("RSA", sizeRSA, policy); msg != "" {
+			allErrs = append(allErrs, field.Invalid(defaultsPath.Child("sizeRSA"), sizeRSA, msg))
+		}
+	}
+	if IsPrivateKeyAlgorithmAllowed(policy, "ECDSA") {
+		if msg := PrivateKeyPolicyViolation("ECDSA", sizeECDSA, policy); msg != "" {
+			allErrs = append(allErrs, field.Invalid(defaultsPath.Child("sizeECDSA"), sizeECDSA, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCustomIssuerPolicy(policy *config.CustomIssuerPolicy, shootIssuers *config.ShootIssuers, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateServerURLs(policy.AllowedServers, fldPath.Child("allowedServers"))...)
+	allErrs = append(allErrs, validateServerURLs(policy.DeniedServers, fldPath.Child("deniedServers"))...)
+	allErrs = append(allErrs, validateServerURLs(policy.ExternalAccountBindingRequiredServers, fldPath.Child("externalAccountBindingRequiredServers"))...)
+	if policy.MaxIssuers != nil && *policy.MaxIssuers < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxIssuers"), *policy.MaxIssuers, "must not be negative"))
+	}
+	for i, server := range policy.ApprovedPrecheckNameservers {
+		if err := ValidateNameserver(server); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("approvedPrecheckNameservers").Index(i), server, err.Error()))
+		}
+	}
+	if shootIssuers != nil && shootIssuers.Policy == config.ShootIssuersPolicyForce && RestrictsIssuerServers(policy) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("shootIssuers", "policy"), "issuers on shoot clusters cannot be enforced, as the custom issuer policy restricts the issuer servers"))
+	}
+
+	return allErrs
+}
+
+func validateIssuerCatalog(cfg *config.Configuration, fldPath *field.Path) field.ErrorList {
+	return validateCatalogIssuers(cfg.IssuerCatalog, sets.New[string](), fldPath)
+}
+
+func validateDefaultIssuerFallback(cfg *config.Configuration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	fallback := cfg.DefaultIssuerFallback
+	if fallback == nil {
+		return allErrs
+	}
+	if len(fallback.Issuers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("issuers"), "at least one fallback issuer must be specified"))
+	}
+	// the fallback issuers are deployed next to the issuer of the configuration, so their names must be distinct
+	allErrs = append(allErrs, validateCatalogIssuers(fallback.Issuers, sets.New(cfg.IssuerName), fldPath.Child("issuers"))...)
+	for i, issuer := range fallback.Issuers {
+		// fallback issuers replace the default issuer, which is available for all shoots
+		if issuer.ShootSelector != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("issuers").Index(i).Child("shootSelector"), "is not supported for fallback issuers"))
+		}
+		if issuer.SeedSelector != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("issuers").Index(i).Child("seedSelector"), "is not supported for fallback issuers"))
+		}
+	}
+	if ptr.Deref(cfg.RestrictIssuer, false) {
+		// the restriction of the fallback issuers to the shoot domains is deployed with their ACME issuer specs
+		for i, issuer := range fallback.Issuers {
+			if issuer.ACME == nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("issuers").Index(i), "fallback issuers of a restricted issuer must be ACME issuers"))
+			}
+		}
+	}
+	if fallback.MinHoldDuration != nil && fallback.MinHoldDuration.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minHoldDuration"), fallback.MinHoldDuration.Duration.String(), "must not be negative"))
+	}
+
+	return allErrs
+}
+
+func validateCatalogIssuers(issuers []config.CatalogIssuer, names sets.Set[string], fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, issuer := range issuers {
+		idxPath := fldPath.Index(i)
+		if issuer.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name is required"))
+		} else if names.Has(issuer.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), issuer.Name))
+		}
+		names.Insert(issuer.Name)
+		if issuer.ShootSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(issuer.ShootSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("shootSelector"))...)
+		}
+		if issuer.SeedSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(issuer.SeedSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("seedSelector"))...)
+		}
+
+		switch {
+		case issuer.ACME != nil && issuer.CA != nil:
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("acme"), "", "only one of ACME or CA can be specified"))
+		case issuer.ACME != nil:
+			allErrs = append(allErrs, validateCatalogACME(issuer.ACME, idxPath.Child("acme"))...)
+		case issuer.CA != nil:
+			if issuer.CA.SecretRef == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("ca", "secretRef"), "the CA of an issuer template must be referenced from a secret"))
+				continue
+			}
+			allErrs = append(allErrs, validateCA(issuer.CA, idxPath.Child("ca"))...)
+		default:
+			allErrs = append(allErrs, field.Required(idxPath.Child("acme"), "at least one of ACME or CA must be specified"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCatalogACME(acme *config.CatalogACME, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if _, err := url.ParseRequestURI(acme.Server); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("server"), acme.Server, "must be a valid url"))
+	}
+	if !utils.TestEmail(acme.Email) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("email"), acme.Email, "must be a valid mail address"))
+	}
+	if acme.PrivateKeySecretRef != nil {
+		allErrs = append(allErrs, validateSecretReference(acme.PrivateKeySecretRef, fldPath.Child("privateKeySecretRef"))...)
+	}
+	if eab := acme.ExternalAccountBinding; eab != nil {
+		eabPath := fldPath.Child("externalAccountBinding")
+		if eab.KeyID == "" {
+			allErrs = append(allErrs, field.Required(eabPath.Child("keyID"), "must not be empty"))
+		}
+		if eab.KeySecretRef == nil {
+			allErrs = append(allErrs, field.Required(eabPath.Child("keySecretRef"), "must be specified"))
+		} else {
+			allErrs = append(allErrs, validateSecretReference(eab.KeySecretRef, eabPath.Child("keySecretRef"))...)
+		}
+	}
+	if ptr.Deref(acme.SkipDNSChallengeValidation, false) && acme.ExternalAccountBinding == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("skipDNSChallengeValidation"), true, "is only allowed for external account binding"))
+	}
+	for i, server := range acme.PrecheckNameservers {
+		if err := ValidateNameserver(server); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("precheckNameservers").Index(i), server, err.Error()))
+		}
+	}
+	if acme.Domains != nil {
+		allErrs = append(allErrs, validateDomains(acme.Domains.Include, fldPath.Child("domains", "include"))...)
+		allErrs = append(allErrs, validateDomains(acme.Domains.Exclude, fldPath.Child("domains", "exclude"))...)
+	}
+
+	return allErrs
+}
+
+func validateProxy(proxy *config.Proxy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if proxy == nil {
+		return allErrs
+	}
+	allErrs = append(allErrs, validateProxyURL(proxy.HTTPProxy, fldPath.Child("httpProxy"))...)
+	allErrs = append(allErrs, validateProxyURL(proxy.HTTPSProxy, fldPath.Child("httpsProxy"))...)
+
+	return allErrs
+}
+
+func validateProxyURL(value *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if value == nil {
+		return allErrs
+	}
+	if u, err := url.Parse(*value); err != nil || u.Scheme == "" || u.Host == "" {
+		// the value is not included, as the proxy URL may contain credentials
+		allErrs = append(allErrs, field.Invalid(fldPath, "", "must be a valid url"))
+	}
+
+	return allErrs
+}
+
+func validateConfigurationOverrides(cfg *config.Configuration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	for i, override := range cfg.Overrides {
+		idxPath := fldPath.Index(i)
+		if override.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name is required"))
+		} else if names.Has(override.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), override.Name))
+		}
+		names.Insert(override.Name)
+
+		if len(override.SeedNames) == 0 && override.SeedSelector == nil && len(override.Regions) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "at least one of seedNames, seedSelector or regions must be specified"))
+		}
+		if override.SeedSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(override.SeedSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("seedSelector"))...)
+		}
+
+		if acme := override.ACME; acme != nil {
+			acmePath := idxPath.Child("acme")
+			if cfg.ACME == nil {
+				allErrs = append(allErrs, field.Forbidden(acmePath, "ACME can only be overridden if the configuration contains ACME"))
+				continue
+			}
+			if acme.Server != "" {
+				if _, err := url.ParseRequestURI(acme.Server); err != nil {
+					allErrs = append(allErrs, field.Invalid(acmePath.Child("server"), acme.Server, err.Error()))
+				}
+				// the default issuer applies to all shoots, so the overridden server must comply with every compliance profile
+				for _, profile := range cfg.ComplianceProfiles {
+					if !IsIssuerServerAllowed(&profile, acme.Server) {
+						allErrs = append(allErrs, field.Forbidden(acmePath.Child("server"), fmt.Sprintf("server is not allowed by compliance profile %q", profile.Name)))
+					}
+				}
+			}
+			if ptr.Deref(acme.SkipDNSChallengeValidation, false) {
+				for _, profile := range cfg.ComplianceProfiles {
+					if !profile.AllowSkipDNSChallengeValidation {
+						allErrs = append(allErrs, field.Forbidden(acmePath.Child("skipDNSChallengeValidation"), fmt.Sprintf("skipping the DNS challenge validation is not allowed by compliance profile %q", profile.Name)))
+					}
+				}
+			}
+			if acme.Email != "" && !utils.TestEmail(acme.Email) {
+				allErrs = append(allErrs, field.Invalid(acmePath.Child("email"), acme.Email, "must be a valid mail address"))
+			}
+			allErrs = append(allErrs, validateACMEOptionalFields(acme, acmePath)...)
+		}
+		allErrs = append(allErrs, validateProxy(override.Proxy, idxPath.Child("proxy"))...)
+	}
+
+	return allErrs
+}
+
+func validateServerURLs(servers []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, server := range servers {
+		if _, err := url.ParseRequestURI(server); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), server, "must be a valid url"))
+		}
+	}
+	return allErrs
+}
+
+func validateComplianceProfiles(cfg *config.Configuration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	for i, profile := range cfg.ComplianceProfiles {
+		idxPath := fldPath.Index(i)
+		if profile.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name is required"))
+		} else if names.Has(profile.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), profile.Name))
+		}
+		names.Insert(profile.Name)
+
+		if profile.SeedSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(profile.SeedSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("seedSelector"))...)
+		}
+		allErrs = append(allErrs, validatePrivateKeyPolicy(profile.PrivateKeys, cfg.PrivateKeyDefaults, idxPath.Child("privateKeys"))...)
+		allErrs = append(allErrs, validateServerURLs(profile.AllowedIssuerServers, idxPath.Child("allowedIssuerServers"))...)
+		if profile.MaxCertificateDuration != nil && profile.MaxCertificateDuration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("maxCertificateDuration"), profile.MaxCertificateDuration.Duration.String(), "must be positive"))
+		}
+
+		// the default issuer and the shoot issuer policy of the operator apply to all shoots, so they must comply with every profile
+		if cfg.ACME != nil {
+			if !IsIssuerServerAllowed(&profile, cfg.ACME.Server) {
+				allErrs = append(allErrs, field.Forbidden(field.NewPath("acme", "server"), fmt.Sprintf("server is not allowed by compliance profile %q", profile.Name)))
+			}
+			if ptr.Deref(cfg.ACME.SkipDNSChallengeValidation, false) && !profile.AllowSkipDNSChallengeValidation {
+				allErrs = append(allErrs, field.Forbidden(field.NewPath("acme", "skipDNSChallengeValidation"), fmt.Sprintf("skipping the DNS challenge validation is not allowed by compliance profile %q", profile.Name)))
+			}
+		}
+		if cfg.DefaultIssuerFallback != nil {
+			for j, issuer := range cfg.DefaultIssuerFallback.Issuers {
+				if issuer.ACME == nil {
+					continue
+				}
+				acmePath := field.NewPath("defaultIssuerFallback", "issuers").Index(j).Child("acme")
+				if !IsIssuerServerAllowed(&profile, issuer.ACME.Server) {
+					allErrs = append(allErrs, field.Forbidden(acmePath.Child("server"), fmt.Sprintf("server is not allowed by compliance profile %q", profile.Name)))
+				}
+				if ptr.Deref(issuer.ACME.SkipDNSChallengeValidation, false) && !profile.AllowSkipDNSChallengeValidation {
+					allErrs = append(allErrs, field.Forbidden(acmePath.Child("skipDNSChallengeValidation"), fmt.Sprintf("skipping the DNS challenge validation is not allowed by compliance profile %q", profile.Name)))
+				}
+			}
+		}
+		if cfg.ShootIssuers != nil && cfg.ShootIssuers.Policy == config.ShootIssuersPolicyForce && len(profile.AllowedIssuerServers) > 0 {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("shootIssuers", "policy"), fmt.Sprintf("issuers on shoot clusters cannot be enforced, as compliance profile %q restricts the issuer servers", profile.Name)))
+		}
+	}
+
+	if cfg.DefaultComplianceProfile != nil && !names.Has(*cfg.DefaultComplianceProfile) {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("defaultComplianceProfile"), *cfg.DefaultComplianceProfile, sets.List(names)))
+	}
+
+	return allErrs
+}
+
+// IsIssuerServerAllowed checks if the compliance profile allows ACME issuers using the given server.
+func IsIssuerServerAllowed(profile *config.ComplianceProfile, server string) bool {
+	return profile == nil || len(profile.AllowedIssuerServers) == 0 || ContainsServerURL(profile.AllowedIssuerServers, server)
+}
+
+// RestrictsIssuerServers checks if the custom issuer policy allows or denies selected ACME servers.
+// Issuers on the shoot cluster are not validated by the extension, so they must be disabled in this case.
+func RestrictsIssuerServers(policy *config.CustomIssuerPolicy) bool {
+	return policy != nil && (len(policy.AllowedServers) > 0 || len(policy.DeniedServers) > 0)
+}
+
+// ContainsServerURL checks if the directory URL of an ACME server is contained in the given URLs.
+// The URLs are compared after normalization, i.e. scheme and host are case-insensitive, the default port is
+// optional and a trailing slash is ignored.
+func ContainsServerURL(servers []string, server string) bool {
+	server = normalizeServerURL(server)
+	for _, s := range servers {
+		if normalizeServerURL(s) == server {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeServerURL(server string) string {
+	u, err := url.Parse(strings.TrimSpace(server))
+	if err != nil {
+		return server
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	host, port := strings.ToLower(u.Hostname()), u.Port()
+	if (u.Scheme == "https" && port == "443") || (u.Scheme == "http" && port == "80") {
+		port = ""
+	}
+	if port != "" {
+		host = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	u.Host = host
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = ""
+	return u.String()
+}
+
+// IsPrivateKeyAlgorithmAllowed checks if the private key policy allows the given algorithm.
+func IsPrivateKeyAlgorithmAllowed(policy *config.PrivateKeyPolicy, algorithm string) bool {
+	return policy == nil || len(policy.AllowedAlgorithms) == 0 || slices.Contains(policy.AllowedAlgorithms, algorithm)
+}
+
+// PrivateKeyPolicyViolation checks a private key with the given algorithm and size against the private key policies.
+// It returns a description of the first violation or an empty string if the private key complies with all policies.
+// A size of 0 is not checked.
+func PrivateKeyPolicyViolation(algorithm string, size int, policies ...*config.PrivateKeyPolicy) string {
+	for _, policy := range policies {
+		if msg := privateKeyPolicyViolation(policy, algorithm, size); msg != "" {
+			return msg
+		}
+	}
+	return ""
+}
+
+func privateKeyPolicyViolation(policy *config.PrivateKeyPolicy, algorithm string, size int) string {
+	if policy == nil {
+		return ""
+	}
+	if !IsPrivateKeyAlgorithmAllowed(policy, algorithm) {
+		return fmt.Sprintf("algorithm is not allowed by the private key policy (allowed: %s)", strings.Join(policy.AllowedAlgorithms, ", "))
+	}
+	var minSize *int
+	switch algorithm {
+	case "RSA":
+		minSize = policy.MinSizeRSA
+	case "ECDSA":
+		minSize = policy.MinSizeECDSA
+	}
+	if size != 0 && minSize != nil && size < *minSize {
+		return fmt.Sprintf("size for %s algorithm must be at least '%d' by the private key policy", algorithm, *minSize)
+	}
+	return ""
+}
+
 func shorten(s string) string {
 	if len(s) > 60 {
 		return s[:30] + "..." + s[len(s)-30:]
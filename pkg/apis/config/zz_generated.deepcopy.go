@@ -11,6 +11,7 @@ package config
 
 import (
 	v1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -23,11 +24,21 @@ func (in *ACME) DeepCopyInto(out *ACME) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PrivateKeySecretRef != nil {
+		in, out := &in.PrivateKeySecretRef, &out.PrivateKeySecretRef
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
 	if in.PropagationTimeout != nil {
 		in, out := &in.PropagationTimeout, &out.PropagationTimeout
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.PrecheckAdditionalWait != nil {
+		in, out := &in.PrecheckAdditionalWait, &out.PrecheckAdditionalWait
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.PrecheckNameservers != nil {
 		in, out := &in.PrecheckNameservers, &out.PrecheckNameservers
 		*out = new(string)
@@ -48,6 +59,26 @@ func (in *ACME) DeepCopyInto(out *ACME) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ExternalAccountBinding != nil {
+		in, out := &in.ExternalAccountBinding, &out.ExternalAccountBinding
+		*out = new(ACMEExternalAccountBinding)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = new(DNSSelection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreferredChain != nil {
+		in, out := &in.PreferredChain, &out.PreferredChain
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyType != nil {
+		in, out := &in.KeyType, &out.KeyType
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -61,9 +92,35 @@ func (in *ACME) DeepCopy() *ACME {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEExternalAccountBinding) DeepCopyInto(out *ACMEExternalAccountBinding) {
+	*out = *in
+	if in.KeySecretRef != nil {
+		in, out := &in.KeySecretRef, &out.KeySecretRef
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEExternalAccountBinding.
+func (in *ACMEExternalAccountBinding) DeepCopy() *ACMEExternalAccountBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEExternalAccountBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CA) DeepCopyInto(out *CA) {
 	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
 	return
 }
 
@@ -77,6 +134,119 @@ func (in *CA) DeepCopy() *CA {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogACME) DeepCopyInto(out *CatalogACME) {
+	*out = *in
+	if in.PrivateKeySecretRef != nil {
+		in, out := &in.PrivateKeySecretRef, &out.PrivateKeySecretRef
+		*out = new(corev1.SecretReference)
+		**out = **in
+	}
+	if in.ExternalAccountBinding != nil {
+		in, out := &in.ExternalAccountBinding, &out.ExternalAccountBinding
+		*out = new(ACMEExternalAccountBinding)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SkipDNSChallengeValidation != nil {
+		in, out := &in.SkipDNSChallengeValidation, &out.SkipDNSChallengeValidation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrecheckNameservers != nil {
+		in, out := &in.PrecheckNameservers, &out.PrecheckNameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = new(DNSSelection)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogACME.
+func (in *CatalogACME) DeepCopy() *CatalogACME {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogACME)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogIssuer) DeepCopyInto(out *CatalogIssuer) {
+	*out = *in
+	if in.ShootSelector != nil {
+		in, out := &in.ShootSelector, &out.ShootSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SeedSelector != nil {
+		in, out := &in.SeedSelector, &out.SeedSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ACME != nil {
+		in, out := &in.ACME, &out.ACME
+		*out = new(CatalogACME)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(CA)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogIssuer.
+func (in *CatalogIssuer) DeepCopy() *CatalogIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComplianceProfile) DeepCopyInto(out *ComplianceProfile) {
+	*out = *in
+	if in.SeedSelector != nil {
+		in, out := &in.SeedSelector, &out.SeedSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateKeys != nil {
+		in, out := &in.PrivateKeys, &out.PrivateKeys
+		*out = new(PrivateKeyPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedIssuerServers != nil {
+		in, out := &in.AllowedIssuerServers, &out.AllowedIssuerServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxCertificateDuration != nil {
+		in, out := &in.MaxCertificateDuration, &out.MaxCertificateDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComplianceProfile.
+func (in *ComplianceProfile) DeepCopy() *ComplianceProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ComplianceProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Configuration) DeepCopyInto(out *Configuration) {
 	*out = *in
@@ -86,6 +256,11 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RestrictedDomainsFromDNSProviders != nil {
+		in, out := &in.RestrictedDomainsFromDNSProviders, &out.RestrictedDomainsFromDNSProviders
+		*out = new(bool)
+		**out = **in
+	}
 	if in.DefaultRequestsPerDayQuota != nil {
 		in, out := &in.DefaultRequestsPerDayQuota, &out.DefaultRequestsPerDayQuota
 		*out = new(int32)
@@ -96,15 +271,25 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		*out = new(ShootIssuers)
 		**out = **in
 	}
+	if in.AllowDisableGardenIssuer != nil {
+		in, out := &in.AllowDisableGardenIssuer, &out.AllowDisableGardenIssuer
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ACME != nil {
 		in, out := &in.ACME, &out.ACME
 		*out = new(ACME)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootACMELimits != nil {
+		in, out := &in.ShootACMELimits, &out.ShootACMELimits
+		*out = new(ShootACMELimits)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CA != nil {
 		in, out := &in.CA, &out.CA
 		*out = new(CA)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.HealthCheckConfig != nil {
 		in, out := &in.HealthCheckConfig, &out.HealthCheckConfig
@@ -116,6 +301,52 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		*out = new(PrivateKeyDefaults)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PrivateKeyPolicy != nil {
+		in, out := &in.PrivateKeyPolicy, &out.PrivateKeyPolicy
+		*out = new(PrivateKeyPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomIssuerPolicy != nil {
+		in, out := &in.CustomIssuerPolicy, &out.CustomIssuerPolicy
+		*out = new(CustomIssuerPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IssuerCatalog != nil {
+		in, out := &in.IssuerCatalog, &out.IssuerCatalog
+		*out = make([]CatalogIssuer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultIssuerFallback != nil {
+		in, out := &in.DefaultIssuerFallback, &out.DefaultIssuerFallback
+		*out = new(DefaultIssuerFallback)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ComplianceProfiles != nil {
+		in, out := &in.ComplianceProfiles, &out.ComplianceProfiles
+		*out = make([]ComplianceProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultComplianceProfile != nil {
+		in, out := &in.DefaultComplianceProfile, &out.DefaultComplianceProfile
+		*out = new(string)
+		**out = **in
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(Proxy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]ConfigurationOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.InClusterACMEServerNamespaceMatchLabel != nil {
 		in, out := &in.InClusterACMEServerNamespaceMatchLabel, &out.InClusterACMEServerNamespaceMatchLabel
 		*out = make(map[string]string, len(*in))
@@ -144,6 +375,168 @@ func (in *Configuration) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationOverride) DeepCopyInto(out *ConfigurationOverride) {
+	*out = *in
+	if in.SeedNames != nil {
+		in, out := &in.SeedNames, &out.SeedNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SeedSelector != nil {
+		in, out := &in.SeedSelector, &out.SeedSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ACME != nil {
+		in, out := &in.ACME, &out.ACME
+		*out = new(ACME)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(Proxy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationOverride.
+func (in *ConfigurationOverride) DeepCopy() *ConfigurationOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomIssuerPolicy) DeepCopyInto(out *CustomIssuerPolicy) {
+	*out = *in
+	if in.AllowedServers != nil {
+		in, out := &in.AllowedServers, &out.AllowedServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedServers != nil {
+		in, out := &in.DeniedServers, &out.DeniedServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxIssuers != nil {
+		in, out := &in.MaxIssuers, &out.MaxIssuers
+		*out = new(int)
+		**out = **in
+	}
+	if in.ExternalAccountBindingRequiredServers != nil {
+		in, out := &in.ExternalAccountBindingRequiredServers, &out.ExternalAccountBindingRequiredServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApprovedPrecheckNameservers != nil {
+		in, out := &in.ApprovedPrecheckNameservers, &out.ApprovedPrecheckNameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomIssuerPolicy.
+func (in *CustomIssuerPolicy) DeepCopy() *CustomIssuerPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomIssuerPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSelection) DeepCopyInto(out *DNSSelection) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSelection.
+func (in *DNSSelection) DeepCopy() *DNSSelection {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSelection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultIssuerFallback) DeepCopyInto(out *DefaultIssuerFallback) {
+	*out = *in
+	if in.Issuers != nil {
+		in, out := &in.Issuers, &out.Issuers
+		*out = make([]CatalogIssuer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MinHoldDuration != nil {
+		in, out := &in.MinHoldDuration, &out.MinHoldDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultIssuerFallback.
+func (in *DefaultIssuerFallback) DeepCopy() *DefaultIssuerFallback {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultIssuerFallback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DurationLimits) DeepCopyInto(out *DurationLimits) {
+	*out = *in
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DurationLimits.
+func (in *DurationLimits) DeepCopy() *DurationLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(DurationLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateKeyDefaults) DeepCopyInto(out *PrivateKeyDefaults) {
 	*out = *in
@@ -175,6 +568,94 @@ func (in *PrivateKeyDefaults) DeepCopy() *PrivateKeyDefaults {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateKeyPolicy) DeepCopyInto(out *PrivateKeyPolicy) {
+	*out = *in
+	if in.AllowedAlgorithms != nil {
+		in, out := &in.AllowedAlgorithms, &out.AllowedAlgorithms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinSizeRSA != nil {
+		in, out := &in.MinSizeRSA, &out.MinSizeRSA
+		*out = new(int)
+		**out = **in
+	}
+	if in.MinSizeECDSA != nil {
+		in, out := &in.MinSizeECDSA, &out.MinSizeECDSA
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateKeyPolicy.
+func (in *PrivateKeyPolicy) DeepCopy() *PrivateKeyPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateKeyPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Proxy) DeepCopyInto(out *Proxy) {
+	*out = *in
+	if in.HTTPProxy != nil {
+		in, out := &in.HTTPProxy, &out.HTTPProxy
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTPSProxy != nil {
+		in, out := &in.HTTPSProxy, &out.HTTPSProxy
+		*out = new(string)
+		**out = **in
+	}
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Proxy.
+func (in *Proxy) DeepCopy() *Proxy {
+	if in == nil {
+		return nil
+	}
+	out := new(Proxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootACMELimits) DeepCopyInto(out *ShootACMELimits) {
+	*out = *in
+	if in.PropagationTimeout != nil {
+		in, out := &in.PropagationTimeout, &out.PropagationTimeout
+		*out = new(DurationLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrecheckAdditionalWait != nil {
+		in, out := &in.PrecheckAdditionalWait, &out.PrecheckAdditionalWait
+		*out = new(DurationLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootACMELimits.
+func (in *ShootACMELimits) DeepCopy() *ShootACMELimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootACMELimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootIssuers) DeepCopyInto(out *ShootIssuers) {
 	*out = *in
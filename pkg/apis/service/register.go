@@ -30,6 +30,7 @@ var (
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&CertConfig{},
+		&CertStatus{},
 	)
 	return nil
 }
@@ -8,6 +8,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DefaultClusterCAIssuerName is the default name of the issuer using the generated per-shoot root CA.
+const DefaultClusterCAIssuerName = "cluster-ca"
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // CertConfig configuration resource
@@ -25,10 +28,25 @@ type CertConfig struct {
 	// If specified, it overwrites the ShootIssuers settings of the service configuration.
 	ShootIssuers *ShootIssuers
 
+	// DefaultIssuer selects the issuer used for certificates without explicit issuer in the shoot cluster.
+	// If not specified, the issuer of the service configuration is used.
+	DefaultIssuer *DefaultIssuer
+
 	// PrecheckNameservers is used to specify a comma-separated list of DNS servers for checking availability for DNS
 	// challenge before calling ACME CA. Please consider to specify nameservers per issuer instead.
 	PrecheckNameservers *string
 
+	// ACME contains settings for the ACME issuers overwriting the settings of the service configuration.
+	ACME *ACMESettings
+
+	// PrivateKeyDefaults overwrites the default algorithm and sizes for certificate private keys of the service configuration.
+	// The private keys must comply with the private key policy of the operator.
+	PrivateKeyDefaults *PrivateKeyDefaults
+
+	// ComplianceProfile is the name of a compliance profile of the service configuration to be applied to the shoot cluster.
+	// It must not be specified if the operator binds a profile to the shoot cluster.
+	ComplianceProfile *string
+
 	// Alerting contains configuration for alerting of certificate expiration.
 	Alerting *Alerting
 
@@ -36,6 +54,187 @@ type CertConfig struct {
 	// This is only relevant for the Garden runtime or seed cluster.
 	// If not specified, the default value is false.
 	GenerateControlPlaneCertificate *bool
+
+	// ClusterCAIssuer configures an additional CA issuer using a root CA generated for the shoot cluster.
+	// It is intended for cluster-internal TLS certificates (e.g. for webhooks or `*.svc.cluster.local` names).
+	ClusterCAIssuer *ClusterCAIssuer
+
+	// TrustBundle configures the distribution of the CA certificates of the issuers into the shoot cluster.
+	TrustBundle *TrustBundle
+
+	// CSRSigner configures a signer for Kubernetes certificate signing requests (`certificates.k8s.io/v1`) in the shoot cluster.
+	CSRSigner *CSRSigner
+
+	// IngressCertificate configures a wildcard certificate for the ingress domain of the shoot cluster.
+	IngressCertificate *IngressCertificate
+
+	// Certificates are certificates to be created in the shoot cluster.
+	Certificates []Certificate
+
+	// KubeAPIServerSNI configures a certificate for the domains of the kube-apiserver of the shoot cluster.
+	KubeAPIServerSNI *KubeAPIServerSNI
+
+	// SecretReplication configures the replication of certificate secrets into other namespaces of the shoot cluster.
+	SecretReplication *SecretReplication
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertStatus is the status of the certificate service reported in the provider status of the Extension resource.
+type CertStatus struct {
+	metav1.TypeMeta
+
+	// ShootIssuers contains the effective enablement of issuers on the shoot cluster.
+	ShootIssuers *ShootIssuers
+	// DefaultIssuer contains the issuer currently used for certificates without explicit issuer, if fallback issuers are configured.
+	DefaultIssuer *DefaultIssuerStatus
+	// EffectiveConfiguration contains the settings of the service configuration effective for the shoot cluster.
+	EffectiveConfiguration *EffectiveConfiguration
+	// ACMEAccountKeyRotations contains the last rotations of the account keys of auto-registered ACME issuers.
+	ACMEAccountKeyRotations []ACMEAccountKeyRotation
+	// SecretReplicationEnabled is true if secret replicas may exist in the shoot cluster, as the secret replication
+	// was enabled. The replicas are deleted if the replication is disabled afterwards or the extension is deleted.
+	SecretReplicationEnabled bool
+	// TrustBundleCopiesEnabled is true if copies of the trust bundle config map may exist in the namespaces of the shoot
+	// cluster, as the trust bundle selected namespaces. The copies are deleted if the namespace selector is removed
+	// afterwards or the extension is deleted.
+	TrustBundleCopiesEnabled bool
+}
+
+// ACMEAccountKeyRotation contains the last rotation of the account key of an ACME issuer.
+type ACMEAccountKeyRotation struct {
+	// Issuer is the name of the issuer.
+	Issuer string
+	// LastRotationTime is the time the account key was rolled over at the ACME server.
+	LastRotationTime metav1.Time
+}
+
+// EffectiveConfiguration contains the settings of the service configuration effective for the shoot cluster
+// after applying the configuration overrides of the operator.
+type EffectiveConfiguration struct {
+	// Overrides are the names of the applied configuration overrides in the order of application.
+	Overrides []string
+	// ACMEServer is the directory URL of the ACME server of the issuer provided by Gardener.
+	ACMEServer *string
+	// PrecheckNameservers are the DNS servers used for checking the propagation of DNS01 challenges.
+	PrecheckNameservers *string
+	// ProxyEnabled is true if a proxy is used for the outgoing connections of the cert-controller-manager.
+	ProxyEnabled bool
+}
+
+// DefaultIssuerStatus contains the issuer currently used for certificates without explicit issuer.
+type DefaultIssuerStatus struct {
+	// Name is the name of the issuer.
+	Name string
+	// Fallback is true if a fallback issuer is used instead of the issuer provided by Gardener.
+	Fallback bool
+	// Message describes why the issuer was selected.
+	Message string
+	// LastTransitionTime is the time the issuer was selected.
+	LastTransitionTime metav1.Time
+}
+
+// SecretReplication contains the configuration for replicating certificate secrets into other namespaces of the shoot cluster.
+// Besides the configured secrets, the secrets of certificates annotated with `service.cert.extensions.gardener.cloud/replicate-to-namespaces`
+// are replicated into the namespaces matching the label selector given as annotation value.
+type SecretReplication struct {
+	// Enabled controls if certificate secrets are replicated.
+	Enabled bool
+	// Secrets are the certificate secrets to replicate.
+	Secrets []ReplicatedSecret
+}
+
+// ReplicatedSecret is a certificate secret replicated into the namespaces matching the namespace selector.
+type ReplicatedSecret struct {
+	// SecretName is the name of the certificate secret.
+	SecretName string
+	// SecretNamespace is the namespace of the certificate secret. Defaults to `default`.
+	SecretNamespace *string
+	// NamespaceSelector selects the namespaces of the replicas.
+	NamespaceSelector metav1.LabelSelector
+}
+
+// KubeAPIServerSNI contains the configuration of the certificate for the domains of the kube-apiserver.
+// The certificate is requested with the default issuer and provided as TLS secret in the control plane namespace
+// of the shoot on the seed, as expected for the SNI configuration of the kube-apiserver.
+type KubeAPIServerSNI struct {
+	// Enabled controls if the certificate is requested.
+	Enabled bool
+	// AdditionalDomains are further domains of the kube-apiserver. The external domain `api.<shoot domain>` is always included.
+	AdditionalDomains []string
+}
+
+// Certificate contains the specification of a certificate created in the shoot cluster.
+type Certificate struct {
+	// Name is the name of the certificate resource. It is created in the namespace of the secret.
+	Name string
+	// DNSNames are the DNS names of the certificate. The first DNS name is used as common name if it is short enough.
+	DNSNames []string
+	// SecretName is the name of the secret storing the certificate and its private key.
+	SecretName string
+	// SecretNamespace is the namespace of the secret and the certificate resource. Defaults to `default`.
+	SecretNamespace *string
+	// IssuerName is the name of the issuer. If not specified, the default issuer is used.
+	IssuerName *string
+	// PrivateKey contains the algorithm and size of the private key.
+	PrivateKey *CertificatePrivateKey
+	// Duration is the requested lifetime of the certificate.
+	Duration *metav1.Duration
+}
+
+// CertificatePrivateKey contains the algorithm and size of the private key of a certificate.
+type CertificatePrivateKey struct {
+	// Algorithm is the algorithm of the private key ('RSA' or 'ECDSA').
+	Algorithm *string
+	// Size is the key size, i.e. '2048', '3072' or '4096' for RSA and '256' or '384' for ECDSA.
+	Size *int32
+}
+
+// IngressCertificate contains the configuration of the wildcard certificate for the ingress domain of the shoot cluster.
+// The certificate is requested with the default issuer for `*.ingress.<shoot domain>` and stored in a well-known
+// secret in the `kube-system` namespace of the shoot cluster.
+type IngressCertificate struct {
+	// Enabled controls if the wildcard certificate is created.
+	Enabled bool
+	// AdditionalSubdomains are further subdomains of the shoot domain to be included as wildcard DNS names,
+	// e.g. `apps` for `*.apps.<shoot domain>`.
+	AdditionalSubdomains []string
+}
+
+// CSRSigner contains the configuration of the signer for certificate signing requests in the shoot cluster.
+// Requests matching the policy are approved automatically and signed with the CA of a CA issuer.
+type CSRSigner struct {
+	// Enabled controls if the certificate signing requests are processed.
+	Enabled bool
+	// SignerName is the signer name of the certificate signing requests to process, e.g. `example.com/internal`.
+	SignerName string
+	// IssuerName is the name of the CA issuer used for signing. It must either be a custom CA issuer, the cluster CA issuer
+	// or the default issuer if it is a CA issuer. If not specified, the default issuer is used.
+	IssuerName *string
+	// AllowedNamespaces are the namespaces of the service accounts allowed to request certificates.
+	AllowedNamespaces []string
+	// AllowedDNSNames are the patterns of DNS names allowed as subject alternative names or common name.
+	// A pattern with a leading `*.` matches exactly one additional label like a wildcard certificate.
+	AllowedDNSNames []string
+}
+
+// TrustBundle contains the configuration for publishing the CA bundle of the issuers in the shoot cluster.
+type TrustBundle struct {
+	// Enabled controls if the config map containing the CA bundle is created in the `kube-system` namespace
+	// of the shoot cluster.
+	Enabled bool
+	// NamespaceSelector optionally selects additional namespaces of the shoot cluster for the config map.
+	// The config map is copied into the selected namespaces, which are watched for changes.
+	NamespaceSelector *metav1.LabelSelector
+}
+
+// ClusterCAIssuer contains the configuration for the issuer using a generated per-shoot root CA.
+// The CA is persisted in the shoot control plane namespace and rotated together with the shoot cluster CAs.
+type ClusterCAIssuer struct {
+	// Enabled controls if the CA is generated and the issuer is created.
+	Enabled bool
+	// Name is the name of the issuer. If not specified, the name `cluster-ca` is used.
+	Name *string
 }
 
 // Alerting contains configuration for alerting of certificate expiration.
@@ -70,6 +269,37 @@ type IssuerConfig struct {
 	// PrecheckNameservers overwrites the default precheck nameservers used for checking DNS propagation.
 	// Format `host` or `host:port`, e.g. "8.8.8.8" same as "8.8.8.8:53" or "google-public-dns-a.google.com:53".
 	PrecheckNameservers []string
+
+	// CACertificatesResourceName is the name of the referenced resource in the shoot spec (`spec.resources`) of a secret
+	// or config map containing custom root certificates in PEM format (data key `ca.crt`) to trust the ACME server,
+	// e.g. an ACME server with a certificate issued by an internal CA.
+	// The root certificates are not restricted to this issuer, but trusted for the connections to the ACME servers of all
+	// issuers of the shoot cluster. Therefore, they are only allowed if the garden issuer is disabled.
+	CACertificatesResourceName *string
+
+	// PreferredChain is the Subject Common Name of the issuer of the preferred certificate chain, if the ACME server offers
+	// alternate chains. It is the default for the certificates of the provider config issued by this issuer.
+	PreferredChain *string
+
+	// KeyType is the type of the private key ('RSA2048', 'RSA3072', 'RSA4096', 'ECDSA256' or 'ECDSA384').
+	// It is the default for the certificates of the provider config issued by this issuer.
+	KeyType *string
+
+	// CA configures a CA issuer instead of an ACME issuer.
+	// If specified, `server` and `email` and all other ACME specific fields must not be set.
+	CA *CAIssuerConfig
+
+	// CatalogIssuer is the name of an issuer template of the operator's issuer catalog.
+	// If specified, the issuer is configured by the template and only `name`, `requestsPerDayQuota`, `domains`,
+	// `preferredChain` and `keyType` may be set.
+	CatalogIssuer *string
+}
+
+// CAIssuerConfig contains the configuration for a CA issuer.
+type CAIssuerConfig struct {
+	// SecretName is the name of the referenced resource in the shoot spec (`spec.resources`) of the
+	// TLS secret containing the CA certificate (data key `tls.crt`) and the CA private key (data key `tls.key`).
+	SecretName string
 }
 
 // DNSChallengeOnShoot is used to create DNS01 challenges on shoot and not on seed.
@@ -99,6 +329,37 @@ type ACMEExternalAccountBinding struct {
 	KeySecretName string
 }
 
+// ACMESettings contains shoot specific settings for the ACME issuers.
+// The durations must be within the limits defined by the operator.
+type ACMESettings struct {
+	// PropagationTimeout is the timeout for DNS01 challenges.
+	PropagationTimeout *metav1.Duration
+	// PrecheckAdditionalWait is the additional wait time after the DNS propagation check of DNS01 challenges.
+	PrecheckAdditionalWait *metav1.Duration
+	// DeactivateAuthorizations enables deactivation of authorizations after successful certificate requests.
+	DeactivateAuthorizations *bool
+}
+
+// PrivateKeyDefaults contains the default algorithm and sizes for certificate private keys of the shoot cluster.
+type PrivateKeyDefaults struct {
+	// Algorithm is the default algorithm ('RSA' or 'ECDSA').
+	Algorithm *string
+	// SizeRSA is the default size for RSA algorithm.
+	SizeRSA *int32
+	// SizeECDSA is the default size for ECDSA algorithm.
+	SizeECDSA *int32
+}
+
+// DefaultIssuer contains the selection of the default issuer of the shoot cluster.
+type DefaultIssuer struct {
+	// Name is the name of the issuer used as default. It must either be one of the issuers, the cluster CA issuer
+	// or the issuer of the service configuration (garden issuer).
+	Name string
+	// DisableGardenIssuer disables the issuer of the service configuration for the shoot cluster.
+	// It is only allowed if permitted by the operator.
+	DisableGardenIssuer bool
+}
+
 // ShootIssuers holds enablement for issuers on shoot cluster
 // If specified, it overwrites the ShootIssuers settings of the service configuration.
 type ShootIssuers struct {
@@ -38,6 +38,38 @@ const CertManagementChartNameGarden = "cert-management-garden"
 // CertManagementChartNameSeed is the name of the chart for Cert-Management deployment on the seed cluster.
 const CertManagementChartNameSeed = "cert-management-seed"
 
+// TrustBundleConfigMapName is the name of the config map containing the CA bundle in the shoot cluster.
+const TrustBundleConfigMapName = "shoot-cert-service-trust-bundle"
+
+// TrustBundleDataKey is the data key of the CA bundle in the trust bundle config map.
+const TrustBundleDataKey = "ca.crt"
+
+// TrustBundleCopyLabel is the label marking the copies of the trust bundle config map in the namespaces selected by the
+// namespace selector of the trust bundle.
+const TrustBundleCopyLabel = "service.cert.extensions.gardener.cloud/trust-bundle-copy"
+
+// IngressCertificateSecretName is the name of the secret containing the wildcard certificate for the ingress domain
+// in the `kube-system` namespace of the shoot cluster.
+const IngressCertificateSecretName = "shoot-cert-service-ingress-wildcard"
+
+// KubeAPIServerSNICertificateName is the name of the certificate for the kube-apiserver domains
+// in the control plane namespace of the shoot on the seed.
+const KubeAPIServerSNICertificateName = "shoot-cert-service-kube-apiserver-sni"
+
+// KubeAPIServerSNISecretName is the name of the TLS secret for the SNI configuration of the kube-apiserver
+// in the control plane namespace of the shoot on the seed.
+const KubeAPIServerSNISecretName = "kube-apiserver-sni-shoot-cert-service"
+
+const (
+	// ReplicateToNamespacesAnnotation is the annotation on a certificate in the shoot cluster to replicate its secret
+	// into the namespaces matching the label selector given as value.
+	ReplicateToNamespacesAnnotation = "service.cert.extensions.gardener.cloud/replicate-to-namespaces"
+	// ReplicaLabel is the label marking secrets replicated by the shoot cert service.
+	ReplicaLabel = "service.cert.extensions.gardener.cloud/replica"
+	// ReplicatedFromAnnotation is the annotation on a replicated secret containing the key of the original secret.
+	ReplicatedFromAnnotation = "service.cert.extensions.gardener.cloud/replicated-from"
+)
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -58,11 +90,30 @@ type CertConfig struct {
 	// +optional
 	ShootIssuers *ShootIssuers `json:"shootIssuers,omitempty"`
 
+	// DefaultIssuer selects the issuer used for certificates without explicit issuer in the shoot cluster.
+	// If not specified, the issuer of the service configuration is used.
+	// +optional
+	DefaultIssuer *DefaultIssuer `json:"defaultIssuer,omitempty"`
+
 	// PrecheckNameservers is used to specify a comma-separated list of DNS servers for checking availability for DNS
 	// challenge before calling ACME CA. Please consider to specify nameservers per issuer instead.
 	// +optional
 	PrecheckNameservers *string `json:"precheckNameservers,omitempty"`
 
+	// ACME contains settings for the ACME issuers overwriting the settings of the service configuration.
+	// +optional
+	ACME *ACMESettings `json:"acme,omitempty"`
+
+	// PrivateKeyDefaults overwrites the default algorithm and sizes for certificate private keys of the service configuration.
+	// The private keys must comply with the private key policy of the operator.
+	// +optional
+	PrivateKeyDefaults *PrivateKeyDefaults `json:"privateKeyDefaults,omitempty"`
+
+	// ComplianceProfile is the name of a compliance profile of the service configuration to be applied to the shoot cluster.
+	// It must not be specified if the operator binds a profile to the shoot cluster.
+	// +optional
+	ComplianceProfile *string `json:"complianceProfile,omitempty"`
+
 	// Alerting contains configuration for alerting of certificate expiration.
 	// +optional
 	Alerting *Alerting `json:"alerting,omitempty"`
@@ -72,6 +123,218 @@ type CertConfig struct {
 	// If not specified, the default value is false.
 	// +optional
 	GenerateControlPlaneCertificate *bool `json:"generateControlPlaneCertificate,omitempty"`
+
+	// ClusterCAIssuer configures an additional CA issuer using a root CA generated for the shoot cluster.
+	// It is intended for cluster-internal TLS certificates (e.g. for webhooks or `*.svc.cluster.local` names).
+	// +optional
+	ClusterCAIssuer *ClusterCAIssuer `json:"clusterCAIssuer,omitempty"`
+
+	// TrustBundle configures the distribution of the CA certificates of the issuers into the shoot cluster.
+	// +optional
+	TrustBundle *TrustBundle `json:"trustBundle,omitempty"`
+
+	// CSRSigner configures a signer for Kubernetes certificate signing requests (`certificates.k8s.io/v1`) in the shoot cluster.
+	// +optional
+	CSRSigner *CSRSigner `json:"csrSigner,omitempty"`
+
+	// IngressCertificate configures a wildcard certificate for the ingress domain of the shoot cluster.
+	// +optional
+	IngressCertificate *IngressCertificate `json:"ingressCertificate,omitempty"`
+
+	// Certificates are certificates to be created in the shoot cluster.
+	// +optional
+	Certificates []Certificate `json:"certificates,omitempty"`
+
+	// KubeAPIServerSNI configures a certificate for the domains of the kube-apiserver of the shoot cluster.
+	// +optional
+	KubeAPIServerSNI *KubeAPIServerSNI `json:"kubeAPIServerSNI,omitempty"`
+
+	// SecretReplication configures the replication of certificate secrets into other namespaces of the shoot cluster.
+	// +optional
+	SecretReplication *SecretReplication `json:"secretReplication,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertStatus is the status of the certificate service reported in the provider status of the Extension resource.
+type CertStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// ShootIssuers contains the effective enablement of issuers on the shoot cluster.
+	// +optional
+	ShootIssuers *ShootIssuers `json:"shootIssuers,omitempty"`
+	// DefaultIssuer contains the issuer currently used for certificates without explicit issuer, if fallback issuers are configured.
+	// +optional
+	DefaultIssuer *DefaultIssuerStatus `json:"defaultIssuer,omitempty"`
+	// EffectiveConfiguration contains the settings of the service configuration effective for the shoot cluster.
+	// +optional
+	EffectiveConfiguration *EffectiveConfiguration `json:"effectiveConfiguration,omitempty"`
+	// ACMEAccountKeyRotations contains the last rotations of the account keys of auto-registered ACME issuers.
+	// +optional
+	ACMEAccountKeyRotations []ACMEAccountKeyRotation `json:"acmeAccountKeyRotations,omitempty"`
+	// SecretReplicationEnabled is true if secret replicas may exist in the shoot cluster, as the secret replication
+	// was enabled. The replicas are deleted if the replication is disabled afterwards or the extension is deleted.
+	// +optional
+	SecretReplicationEnabled bool `json:"secretReplicationEnabled,omitempty"`
+	// TrustBundleCopiesEnabled is true if copies of the trust bundle config map may exist in the namespaces of the shoot
+	// cluster, as the trust bundle selected namespaces. The copies are deleted if the namespace selector is removed
+	// afterwards or the extension is deleted.
+	// +optional
+	TrustBundleCopiesEnabled bool `json:"trustBundleCopiesEnabled,omitempty"`
+}
+
+// ACMEAccountKeyRotation contains the last rotation of the account key of an ACME issuer.
+type ACMEAccountKeyRotation struct {
+	// Issuer is the name of the issuer.
+	Issuer string `json:"issuer"`
+	// LastRotationTime is the time the account key was rolled over at the ACME server.
+	LastRotationTime metav1.Time `json:"lastRotationTime"`
+}
+
+// EffectiveConfiguration contains the settings of the service configuration effective for the shoot cluster
+// after applying the configuration overrides of the operator.
+type EffectiveConfiguration struct {
+	// Overrides are the names of the applied configuration overrides in the order of application.
+	// +optional
+	Overrides []string `json:"overrides,omitempty"`
+	// ACMEServer is the directory URL of the ACME server of the issuer provided by Gardener.
+	// +optional
+	ACMEServer *string `json:"acmeServer,omitempty"`
+	// PrecheckNameservers are the DNS servers used for checking the propagation of DNS01 challenges.
+	// +optional
+	PrecheckNameservers *string `json:"precheckNameservers,omitempty"`
+	// ProxyEnabled is true if a proxy is used for the outgoing connections of the cert-controller-manager.
+	// +optional
+	ProxyEnabled bool `json:"proxyEnabled,omitempty"`
+}
+
+// DefaultIssuerStatus contains the issuer currently used for certificates without explicit issuer.
+type DefaultIssuerStatus struct {
+	// Name is the name of the issuer.
+	Name string `json:"name"`
+	// Fallback is true if a fallback issuer is used instead of the issuer provided by Gardener.
+	Fallback bool `json:"fallback"`
+	// Message describes why the issuer was selected.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime is the time the issuer was selected.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+// SecretReplication contains the configuration for replicating certificate secrets into other namespaces of the shoot cluster.
+// Besides the configured secrets, the secrets of certificates annotated with `service.cert.extensions.gardener.cloud/replicate-to-namespaces`
+// are replicated into the namespaces matching the label selector given as annotation value.
+type SecretReplication struct {
+	// Enabled controls if certificate secrets are replicated.
+	Enabled bool `json:"enabled"`
+	// Secrets are the certificate secrets to replicate.
+	// +optional
+	Secrets []ReplicatedSecret `json:"secrets,omitempty"`
+}
+
+// ReplicatedSecret is a certificate secret replicated into the namespaces matching the namespace selector.
+type ReplicatedSecret struct {
+	// SecretName is the name of the certificate secret.
+	SecretName string `json:"secretName"`
+	// SecretNamespace is the namespace of the certificate secret. Defaults to `default`.
+	// +optional
+	SecretNamespace *string `json:"secretNamespace,omitempty"`
+	// NamespaceSelector selects the namespaces of the replicas.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+}
+
+// KubeAPIServerSNI contains the configuration of the certificate for the domains of the kube-apiserver.
+// The certificate is requested with the default issuer and provided as TLS secret in the control plane namespace
+// of the shoot on the seed, as expected for the SNI configuration of the kube-apiserver.
+type KubeAPIServerSNI struct {
+	// Enabled controls if the certificate is requested.
+	Enabled bool `json:"enabled"`
+	// AdditionalDomains are further domains of the kube-apiserver. The external domain `api.<shoot domain>` is always included.
+	// +optional
+	AdditionalDomains []string `json:"additionalDomains,omitempty"`
+}
+
+// Certificate contains the specification of a certificate created in the shoot cluster.
+type Certificate struct {
+	// Name is the name of the certificate resource. It is created in the namespace of the secret.
+	Name string `json:"name"`
+	// DNSNames are the DNS names of the certificate. The first DNS name is used as common name if it is short enough.
+	DNSNames []string `json:"dnsNames"`
+	// SecretName is the name of the secret storing the certificate and its private key.
+	SecretName string `json:"secretName"`
+	// SecretNamespace is the namespace of the secret and the certificate resource. Defaults to `default`.
+	// +optional
+	SecretNamespace *string `json:"secretNamespace,omitempty"`
+	// IssuerName is the name of the issuer. If not specified, the default issuer is used.
+	// +optional
+	IssuerName *string `json:"issuerName,omitempty"`
+	// PrivateKey contains the algorithm and size of the private key.
+	// +optional
+	PrivateKey *CertificatePrivateKey `json:"privateKey,omitempty"`
+	// Duration is the requested lifetime of the certificate.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// CertificatePrivateKey contains the algorithm and size of the private key of a certificate.
+type CertificatePrivateKey struct {
+	// Algorithm is the algorithm of the private key ('RSA' or 'ECDSA').
+	// +optional
+	Algorithm *string `json:"algorithm,omitempty"`
+	// Size is the key size, i.e. '2048', '3072' or '4096' for RSA and '256' or '384' for ECDSA.
+	// +optional
+	Size *int32 `json:"size,omitempty"`
+}
+
+// IngressCertificate contains the configuration of the wildcard certificate for the ingress domain of the shoot cluster.
+// The certificate is requested with the default issuer for `*.ingress.<shoot domain>` and stored in a well-known
+// secret in the `kube-system` namespace of the shoot cluster.
+type IngressCertificate struct {
+	// Enabled controls if the wildcard certificate is created.
+	Enabled bool `json:"enabled"`
+	// AdditionalSubdomains are further subdomains of the shoot domain to be included as wildcard DNS names,
+	// e.g. `apps` for `*.apps.<shoot domain>`.
+	// +optional
+	AdditionalSubdomains []string `json:"additionalSubdomains,omitempty"`
+}
+
+// CSRSigner contains the configuration of the signer for certificate signing requests in the shoot cluster.
+// Requests matching the policy are approved automatically and signed with the CA of a CA issuer.
+type CSRSigner struct {
+	// Enabled controls if the certificate signing requests are processed.
+	Enabled bool `json:"enabled"`
+	// SignerName is the signer name of the certificate signing requests to process, e.g. `example.com/internal`.
+	SignerName string `json:"signerName"`
+	// IssuerName is the name of the CA issuer used for signing. It must either be a custom CA issuer, the cluster CA issuer
+	// or the default issuer if it is a CA issuer. If not specified, the default issuer is used.
+	// +optional
+	IssuerName *string `json:"issuerName,omitempty"`
+	// AllowedNamespaces are the namespaces of the service accounts allowed to request certificates.
+	AllowedNamespaces []string `json:"allowedNamespaces"`
+	// AllowedDNSNames are the patterns of DNS names allowed as subject alternative names or common name.
+	// A pattern with a leading `*.` matches exactly one additional label like a wildcard certificate.
+	AllowedDNSNames []string `json:"allowedDNSNames"`
+}
+
+// TrustBundle contains the configuration for publishing the CA bundle of the issuers in the shoot cluster.
+type TrustBundle struct {
+	// Enabled controls if the config map containing the CA bundle is created in the `kube-system` namespace
+	// of the shoot cluster.
+	Enabled bool `json:"enabled"`
+	// NamespaceSelector optionally selects additional namespaces of the shoot cluster for the config map.
+	// The config map is copied into the selected namespaces, which are watched for changes.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// ClusterCAIssuer contains the configuration for the issuer using a generated per-shoot root CA.
+// The CA is persisted in the shoot control plane namespace and rotated together with the shoot cluster CAs.
+type ClusterCAIssuer struct {
+	// Enabled controls if the CA is generated and the issuer is created.
+	Enabled bool `json:"enabled"`
+	// Name is the name of the issuer. If not specified, the name `cluster-ca` is used.
+	// +optional
+	Name *string `json:"name,omitempty"`
 }
 
 // Alerting contains configuration for alerting of certificate expiration.
@@ -113,6 +376,42 @@ type IssuerConfig struct {
 	// Format `host` or `host:port`, e.g. "8.8.8.8" same as "8.8.8.8:53" or "google-public-dns-a.google.com:53".
 	// +optional
 	PrecheckNameservers []string `json:"precheckNameservers,omitempty"`
+
+	// CACertificatesResourceName is the name of the referenced resource in the shoot spec (`spec.resources`) of a secret
+	// or config map containing custom root certificates in PEM format (data key `ca.crt`) to trust the ACME server,
+	// e.g. an ACME server with a certificate issued by an internal CA.
+	// The root certificates are not restricted to this issuer, but trusted for the connections to the ACME servers of all
+	// issuers of the shoot cluster. Therefore, they are only allowed if the garden issuer is disabled.
+	// +optional
+	CACertificatesResourceName *string `json:"caCertificatesResourceName,omitempty"`
+
+	// PreferredChain is the Subject Common Name of the issuer of the preferred certificate chain, if the ACME server offers
+	// alternate chains. It is the default for the certificates of the provider config issued by this issuer.
+	// +optional
+	PreferredChain *string `json:"preferredChain,omitempty"`
+
+	// KeyType is the type of the private key ('RSA2048', 'RSA3072', 'RSA4096', 'ECDSA256' or 'ECDSA384').
+	// It is the default for the certificates of the provider config issued by this issuer.
+	// +optional
+	KeyType *string `json:"keyType,omitempty"`
+
+	// CA configures a CA issuer instead of an ACME issuer.
+	// If specified, `server` and `email` and all other ACME specific fields must not be set.
+	// +optional
+	CA *CAIssuerConfig `json:"ca,omitempty"`
+
+	// CatalogIssuer is the name of an issuer template of the operator's issuer catalog.
+	// If specified, the issuer is configured by the template and only `name`, `requestsPerDayQuota`, `domains`,
+	// `preferredChain` and `keyType` may be set.
+	// +optional
+	CatalogIssuer *string `json:"catalogIssuer,omitempty"`
+}
+
+// CAIssuerConfig contains the configuration for a CA issuer.
+type CAIssuerConfig struct {
+	// SecretName is the name of the referenced resource in the shoot spec (`spec.resources`) of the
+	// TLS secret containing the CA certificate (data key `tls.crt`) and the CA private key (data key `tls.key`).
+	SecretName string `json:"secretName"`
 }
 
 // DNSChallengeOnShoot is used to create DNS01 challenges on shoot and not on seed.
@@ -145,6 +444,44 @@ type ACMEExternalAccountBinding struct {
 	KeySecretName string `json:"keySecretName"`
 }
 
+// ACMESettings contains shoot specific settings for the ACME issuers.
+// The durations must be within the limits defined by the operator.
+type ACMESettings struct {
+	// PropagationTimeout is the timeout for DNS01 challenges.
+	// +optional
+	PropagationTimeout *metav1.Duration `json:"propagationTimeout,omitempty"`
+	// PrecheckAdditionalWait is the additional wait time after the DNS propagation check of DNS01 challenges.
+	// +optional
+	PrecheckAdditionalWait *metav1.Duration `json:"precheckAdditionalWait,omitempty"`
+	// DeactivateAuthorizations enables deactivation of authorizations after successful certificate requests.
+	// +optional
+	DeactivateAuthorizations *bool `json:"deactivateAuthorizations,omitempty"`
+}
+
+// PrivateKeyDefaults contains the default algorithm and sizes for certificate private keys of the shoot cluster.
+type PrivateKeyDefaults struct {
+	// Algorithm is the default algorithm ('RSA' or 'ECDSA').
+	// +optional
+	Algorithm *string `json:"algorithm,omitempty"`
+	// SizeRSA is the default size for RSA algorithm.
+	// +optional
+	SizeRSA *int32 `json:"sizeRSA,omitempty"`
+	// SizeECDSA is the default size for ECDSA algorithm.
+	// +optional
+	SizeECDSA *int32 `json:"sizeECDSA,omitempty"`
+}
+
+// DefaultIssuer contains the selection of the default issuer of the shoot cluster.
+type DefaultIssuer struct {
+	// Name is the name of the issuer used as default. It must either be one of the issuers, the cluster CA issuer
+	// or the issuer of the service configuration (garden issuer).
+	Name string `json:"name"`
+	// DisableGardenIssuer disables the issuer of the service configuration for the shoot cluster.
+	// It is only allowed if permitted by the operator.
+	// +optional
+	DisableGardenIssuer bool `json:"disableGardenIssuer,omitempty"`
+}
+
 // ShootIssuers holds enablement for issuers on shoot cluster
 // If specified, it overwrites the ShootIssuers settings of the service configuration.
 type ShootIssuers struct {
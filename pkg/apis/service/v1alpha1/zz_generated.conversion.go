@@ -13,6 +13,7 @@ import (
 	unsafe "unsafe"
 
 	service "github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -24,6 +25,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*ACMEAccountKeyRotation)(nil), (*service.ACMEAccountKeyRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ACMEAccountKeyRotation_To_service_ACMEAccountKeyRotation(a.(*ACMEAccountKeyRotation), b.(*service.ACMEAccountKeyRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.ACMEAccountKeyRotation)(nil), (*ACMEAccountKeyRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_ACMEAccountKeyRotation_To_v1alpha1_ACMEAccountKeyRotation(a.(*service.ACMEAccountKeyRotation), b.(*ACMEAccountKeyRotation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ACMEExternalAccountBinding)(nil), (*service.ACMEExternalAccountBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ACMEExternalAccountBinding_To_service_ACMEExternalAccountBinding(a.(*ACMEExternalAccountBinding), b.(*service.ACMEExternalAccountBinding), scope)
 	}); err != nil {
@@ -34,6 +45,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ACMESettings)(nil), (*service.ACMESettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ACMESettings_To_service_ACMESettings(a.(*ACMESettings), b.(*service.ACMESettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.ACMESettings)(nil), (*ACMESettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_ACMESettings_To_v1alpha1_ACMESettings(a.(*service.ACMESettings), b.(*ACMESettings), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Alerting)(nil), (*service.Alerting)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Alerting_To_service_Alerting(a.(*Alerting), b.(*service.Alerting), scope)
 	}); err != nil {
@@ -44,6 +65,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CAIssuerConfig)(nil), (*service.CAIssuerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CAIssuerConfig_To_service_CAIssuerConfig(a.(*CAIssuerConfig), b.(*service.CAIssuerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.CAIssuerConfig)(nil), (*CAIssuerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_CAIssuerConfig_To_v1alpha1_CAIssuerConfig(a.(*service.CAIssuerConfig), b.(*CAIssuerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CSRSigner)(nil), (*service.CSRSigner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CSRSigner_To_service_CSRSigner(a.(*CSRSigner), b.(*service.CSRSigner), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.CSRSigner)(nil), (*CSRSigner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_CSRSigner_To_v1alpha1_CSRSigner(a.(*service.CSRSigner), b.(*CSRSigner), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertConfig)(nil), (*service.CertConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CertConfig_To_service_CertConfig(a.(*CertConfig), b.(*service.CertConfig), scope)
 	}); err != nil {
@@ -54,6 +95,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertStatus)(nil), (*service.CertStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CertStatus_To_service_CertStatus(a.(*CertStatus), b.(*service.CertStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.CertStatus)(nil), (*CertStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_CertStatus_To_v1alpha1_CertStatus(a.(*service.CertStatus), b.(*CertStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Certificate)(nil), (*service.Certificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Certificate_To_service_Certificate(a.(*Certificate), b.(*service.Certificate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.Certificate)(nil), (*Certificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_Certificate_To_v1alpha1_Certificate(a.(*service.Certificate), b.(*Certificate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CertificatePrivateKey)(nil), (*service.CertificatePrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CertificatePrivateKey_To_service_CertificatePrivateKey(a.(*CertificatePrivateKey), b.(*service.CertificatePrivateKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.CertificatePrivateKey)(nil), (*CertificatePrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_CertificatePrivateKey_To_v1alpha1_CertificatePrivateKey(a.(*service.CertificatePrivateKey), b.(*CertificatePrivateKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterCAIssuer)(nil), (*service.ClusterCAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterCAIssuer_To_service_ClusterCAIssuer(a.(*ClusterCAIssuer), b.(*service.ClusterCAIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.ClusterCAIssuer)(nil), (*ClusterCAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_ClusterCAIssuer_To_v1alpha1_ClusterCAIssuer(a.(*service.ClusterCAIssuer), b.(*ClusterCAIssuer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DNSChallengeOnShoot)(nil), (*service.DNSChallengeOnShoot)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DNSChallengeOnShoot_To_service_DNSChallengeOnShoot(a.(*DNSChallengeOnShoot), b.(*service.DNSChallengeOnShoot), scope)
 	}); err != nil {
@@ -74,6 +155,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DefaultIssuer)(nil), (*service.DefaultIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DefaultIssuer_To_service_DefaultIssuer(a.(*DefaultIssuer), b.(*service.DefaultIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.DefaultIssuer)(nil), (*DefaultIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_DefaultIssuer_To_v1alpha1_DefaultIssuer(a.(*service.DefaultIssuer), b.(*DefaultIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DefaultIssuerStatus)(nil), (*service.DefaultIssuerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DefaultIssuerStatus_To_service_DefaultIssuerStatus(a.(*DefaultIssuerStatus), b.(*service.DefaultIssuerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.DefaultIssuerStatus)(nil), (*DefaultIssuerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_DefaultIssuerStatus_To_v1alpha1_DefaultIssuerStatus(a.(*service.DefaultIssuerStatus), b.(*DefaultIssuerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EffectiveConfiguration)(nil), (*service.EffectiveConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EffectiveConfiguration_To_service_EffectiveConfiguration(a.(*EffectiveConfiguration), b.(*service.EffectiveConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.EffectiveConfiguration)(nil), (*EffectiveConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_EffectiveConfiguration_To_v1alpha1_EffectiveConfiguration(a.(*service.EffectiveConfiguration), b.(*EffectiveConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IngressCertificate)(nil), (*service.IngressCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IngressCertificate_To_service_IngressCertificate(a.(*IngressCertificate), b.(*service.IngressCertificate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.IngressCertificate)(nil), (*IngressCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_IngressCertificate_To_v1alpha1_IngressCertificate(a.(*service.IngressCertificate), b.(*IngressCertificate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IssuerConfig)(nil), (*service.IssuerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_IssuerConfig_To_service_IssuerConfig(a.(*IssuerConfig), b.(*service.IssuerConfig), scope)
 	}); err != nil {
@@ -84,6 +205,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeAPIServerSNI)(nil), (*service.KubeAPIServerSNI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KubeAPIServerSNI_To_service_KubeAPIServerSNI(a.(*KubeAPIServerSNI), b.(*service.KubeAPIServerSNI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.KubeAPIServerSNI)(nil), (*KubeAPIServerSNI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_KubeAPIServerSNI_To_v1alpha1_KubeAPIServerSNI(a.(*service.KubeAPIServerSNI), b.(*KubeAPIServerSNI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrivateKeyDefaults)(nil), (*service.PrivateKeyDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrivateKeyDefaults_To_service_PrivateKeyDefaults(a.(*PrivateKeyDefaults), b.(*service.PrivateKeyDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.PrivateKeyDefaults)(nil), (*PrivateKeyDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_PrivateKeyDefaults_To_v1alpha1_PrivateKeyDefaults(a.(*service.PrivateKeyDefaults), b.(*PrivateKeyDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReplicatedSecret)(nil), (*service.ReplicatedSecret)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ReplicatedSecret_To_service_ReplicatedSecret(a.(*ReplicatedSecret), b.(*service.ReplicatedSecret), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.ReplicatedSecret)(nil), (*ReplicatedSecret)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_ReplicatedSecret_To_v1alpha1_ReplicatedSecret(a.(*service.ReplicatedSecret), b.(*ReplicatedSecret), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretReplication)(nil), (*service.SecretReplication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretReplication_To_service_SecretReplication(a.(*SecretReplication), b.(*service.SecretReplication), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.SecretReplication)(nil), (*SecretReplication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_SecretReplication_To_v1alpha1_SecretReplication(a.(*service.SecretReplication), b.(*SecretReplication), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootIssuers)(nil), (*service.ShootIssuers)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootIssuers_To_service_ShootIssuers(a.(*ShootIssuers), b.(*service.ShootIssuers), scope)
 	}); err != nil {
@@ -94,9 +255,41 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TrustBundle)(nil), (*service.TrustBundle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TrustBundle_To_service_TrustBundle(a.(*TrustBundle), b.(*service.TrustBundle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*service.TrustBundle)(nil), (*TrustBundle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_service_TrustBundle_To_v1alpha1_TrustBundle(a.(*service.TrustBundle), b.(*TrustBundle), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
+func autoConvert_v1alpha1_ACMEAccountKeyRotation_To_service_ACMEAccountKeyRotation(in *ACMEAccountKeyRotation, out *service.ACMEAccountKeyRotation, s conversion.Scope) error {
+	out.Issuer = in.Issuer
+	out.LastRotationTime = in.LastRotationTime
+	return nil
+}
+
+// Convert_v1alpha1_ACMEAccountKeyRotation_To_service_ACMEAccountKeyRotation is an autogenerated conversion function.
+func Convert_v1alpha1_ACMEAccountKeyRotation_To_service_ACMEAccountKeyRotation(in *ACMEAccountKeyRotation, out *service.ACMEAccountKeyRotation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ACMEAccountKeyRotation_To_service_ACMEAccountKeyRotation(in, out, s)
+}
+
+func autoConvert_service_ACMEAccountKeyRotation_To_v1alpha1_ACMEAccountKeyRotation(in *service.ACMEAccountKeyRotation, out *ACMEAccountKeyRotation, s conversion.Scope) error {
+	out.Issuer = in.Issuer
+	out.LastRotationTime = in.LastRotationTime
+	return nil
+}
+
+// Convert_service_ACMEAccountKeyRotation_To_v1alpha1_ACMEAccountKeyRotation is an autogenerated conversion function.
+func Convert_service_ACMEAccountKeyRotation_To_v1alpha1_ACMEAccountKeyRotation(in *service.ACMEAccountKeyRotation, out *ACMEAccountKeyRotation, s conversion.Scope) error {
+	return autoConvert_service_ACMEAccountKeyRotation_To_v1alpha1_ACMEAccountKeyRotation(in, out, s)
+}
+
 func autoConvert_v1alpha1_ACMEExternalAccountBinding_To_service_ACMEExternalAccountBinding(in *ACMEExternalAccountBinding, out *service.ACMEExternalAccountBinding, s conversion.Scope) error {
 	out.KeyID = in.KeyID
 	out.KeySecretName = in.KeySecretName
@@ -119,6 +312,30 @@ func Convert_service_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountB
 	return autoConvert_service_ACMEExternalAccountBinding_To_v1alpha1_ACMEExternalAccountBinding(in, out, s)
 }
 
+func autoConvert_v1alpha1_ACMESettings_To_service_ACMESettings(in *ACMESettings, out *service.ACMESettings, s conversion.Scope) error {
+	out.PropagationTimeout = (*v1.Duration)(unsafe.Pointer(in.PropagationTimeout))
+	out.PrecheckAdditionalWait = (*v1.Duration)(unsafe.Pointer(in.PrecheckAdditionalWait))
+	out.DeactivateAuthorizations = (*bool)(unsafe.Pointer(in.DeactivateAuthorizations))
+	return nil
+}
+
+// Convert_v1alpha1_ACMESettings_To_service_ACMESettings is an autogenerated conversion function.
+func Convert_v1alpha1_ACMESettings_To_service_ACMESettings(in *ACMESettings, out *service.ACMESettings, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ACMESettings_To_service_ACMESettings(in, out, s)
+}
+
+func autoConvert_service_ACMESettings_To_v1alpha1_ACMESettings(in *service.ACMESettings, out *ACMESettings, s conversion.Scope) error {
+	out.PropagationTimeout = (*v1.Duration)(unsafe.Pointer(in.PropagationTimeout))
+	out.PrecheckAdditionalWait = (*v1.Duration)(unsafe.Pointer(in.PrecheckAdditionalWait))
+	out.DeactivateAuthorizations = (*bool)(unsafe.Pointer(in.DeactivateAuthorizations))
+	return nil
+}
+
+// Convert_service_ACMESettings_To_v1alpha1_ACMESettings is an autogenerated conversion function.
+func Convert_service_ACMESettings_To_v1alpha1_ACMESettings(in *service.ACMESettings, out *ACMESettings, s conversion.Scope) error {
+	return autoConvert_service_ACMESettings_To_v1alpha1_ACMESettings(in, out, s)
+}
+
 func autoConvert_v1alpha1_Alerting_To_service_Alerting(in *Alerting, out *service.Alerting, s conversion.Scope) error {
 	out.CertExpirationAlertDays = (*int)(unsafe.Pointer(in.CertExpirationAlertDays))
 	return nil
@@ -139,13 +356,72 @@ func Convert_service_Alerting_To_v1alpha1_Alerting(in *service.Alerting, out *Al
 	return autoConvert_service_Alerting_To_v1alpha1_Alerting(in, out, s)
 }
 
+func autoConvert_v1alpha1_CAIssuerConfig_To_service_CAIssuerConfig(in *CAIssuerConfig, out *service.CAIssuerConfig, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_v1alpha1_CAIssuerConfig_To_service_CAIssuerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CAIssuerConfig_To_service_CAIssuerConfig(in *CAIssuerConfig, out *service.CAIssuerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CAIssuerConfig_To_service_CAIssuerConfig(in, out, s)
+}
+
+func autoConvert_service_CAIssuerConfig_To_v1alpha1_CAIssuerConfig(in *service.CAIssuerConfig, out *CAIssuerConfig, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_service_CAIssuerConfig_To_v1alpha1_CAIssuerConfig is an autogenerated conversion function.
+func Convert_service_CAIssuerConfig_To_v1alpha1_CAIssuerConfig(in *service.CAIssuerConfig, out *CAIssuerConfig, s conversion.Scope) error {
+	return autoConvert_service_CAIssuerConfig_To_v1alpha1_CAIssuerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CSRSigner_To_service_CSRSigner(in *CSRSigner, out *service.CSRSigner, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.SignerName = in.SignerName
+	out.IssuerName = (*string)(unsafe.Pointer(in.IssuerName))
+	out.AllowedNamespaces = *(*[]string)(unsafe.Pointer(&in.AllowedNamespaces))
+	out.AllowedDNSNames = *(*[]string)(unsafe.Pointer(&in.AllowedDNSNames))
+	return nil
+}
+
+// Convert_v1alpha1_CSRSigner_To_service_CSRSigner is an autogenerated conversion function.
+func Convert_v1alpha1_CSRSigner_To_service_CSRSigner(in *CSRSigner, out *service.CSRSigner, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CSRSigner_To_service_CSRSigner(in, out, s)
+}
+
+func autoConvert_service_CSRSigner_To_v1alpha1_CSRSigner(in *service.CSRSigner, out *CSRSigner, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.SignerName = in.SignerName
+	out.IssuerName = (*string)(unsafe.Pointer(in.IssuerName))
+	out.AllowedNamespaces = *(*[]string)(unsafe.Pointer(&in.AllowedNamespaces))
+	out.AllowedDNSNames = *(*[]string)(unsafe.Pointer(&in.AllowedDNSNames))
+	return nil
+}
+
+// Convert_service_CSRSigner_To_v1alpha1_CSRSigner is an autogenerated conversion function.
+func Convert_service_CSRSigner_To_v1alpha1_CSRSigner(in *service.CSRSigner, out *CSRSigner, s conversion.Scope) error {
+	return autoConvert_service_CSRSigner_To_v1alpha1_CSRSigner(in, out, s)
+}
+
 func autoConvert_v1alpha1_CertConfig_To_service_CertConfig(in *CertConfig, out *service.CertConfig, s conversion.Scope) error {
 	out.Issuers = *(*[]service.IssuerConfig)(unsafe.Pointer(&in.Issuers))
 	out.DNSChallengeOnShoot = (*service.DNSChallengeOnShoot)(unsafe.Pointer(in.DNSChallengeOnShoot))
 	out.ShootIssuers = (*service.ShootIssuers)(unsafe.Pointer(in.ShootIssuers))
+	out.DefaultIssuer = (*service.DefaultIssuer)(unsafe.Pointer(in.DefaultIssuer))
 	out.PrecheckNameservers = (*string)(unsafe.Pointer(in.PrecheckNameservers))
+	out.ACME = (*service.ACMESettings)(unsafe.Pointer(in.ACME))
+	out.PrivateKeyDefaults = (*service.PrivateKeyDefaults)(unsafe.Pointer(in.PrivateKeyDefaults))
+	out.ComplianceProfile = (*string)(unsafe.Pointer(in.ComplianceProfile))
 	out.Alerting = (*service.Alerting)(unsafe.Pointer(in.Alerting))
 	out.GenerateControlPlaneCertificate = (*bool)(unsafe.Pointer(in.GenerateControlPlaneCertificate))
+	out.ClusterCAIssuer = (*service.ClusterCAIssuer)(unsafe.Pointer(in.ClusterCAIssuer))
+	out.TrustBundle = (*service.TrustBundle)(unsafe.Pointer(in.TrustBundle))
+	out.CSRSigner = (*service.CSRSigner)(unsafe.Pointer(in.CSRSigner))
+	out.IngressCertificate = (*service.IngressCertificate)(unsafe.Pointer(in.IngressCertificate))
+	out.Certificates = *(*[]service.Certificate)(unsafe.Pointer(&in.Certificates))
+	out.KubeAPIServerSNI = (*service.KubeAPIServerSNI)(unsafe.Pointer(in.KubeAPIServerSNI))
+	out.SecretReplication = (*service.SecretReplication)(unsafe.Pointer(in.SecretReplication))
 	return nil
 }
 
@@ -158,9 +434,20 @@ func autoConvert_service_CertConfig_To_v1alpha1_CertConfig(in *service.CertConfi
 	out.Issuers = *(*[]IssuerConfig)(unsafe.Pointer(&in.Issuers))
 	out.DNSChallengeOnShoot = (*DNSChallengeOnShoot)(unsafe.Pointer(in.DNSChallengeOnShoot))
 	out.ShootIssuers = (*ShootIssuers)(unsafe.Pointer(in.ShootIssuers))
+	out.DefaultIssuer = (*DefaultIssuer)(unsafe.Pointer(in.DefaultIssuer))
 	out.PrecheckNameservers = (*string)(unsafe.Pointer(in.PrecheckNameservers))
+	out.ACME = (*ACMESettings)(unsafe.Pointer(in.ACME))
+	out.PrivateKeyDefaults = (*PrivateKeyDefaults)(unsafe.Pointer(in.PrivateKeyDefaults))
+	out.ComplianceProfile = (*string)(unsafe.Pointer(in.ComplianceProfile))
 	out.Alerting = (*Alerting)(unsafe.Pointer(in.Alerting))
 	out.GenerateControlPlaneCertificate = (*bool)(unsafe.Pointer(in.GenerateControlPlaneCertificate))
+	out.ClusterCAIssuer = (*ClusterCAIssuer)(unsafe.Pointer(in.ClusterCAIssuer))
+	out.TrustBundle = (*TrustBundle)(unsafe.Pointer(in.TrustBundle))
+	out.CSRSigner = (*CSRSigner)(unsafe.Pointer(in.CSRSigner))
+	out.IngressCertificate = (*IngressCertificate)(unsafe.Pointer(in.IngressCertificate))
+	out.Certificates = *(*[]Certificate)(unsafe.Pointer(&in.Certificates))
+	out.KubeAPIServerSNI = (*KubeAPIServerSNI)(unsafe.Pointer(in.KubeAPIServerSNI))
+	out.SecretReplication = (*SecretReplication)(unsafe.Pointer(in.SecretReplication))
 	return nil
 }
 
@@ -169,6 +456,112 @@ func Convert_service_CertConfig_To_v1alpha1_CertConfig(in *service.CertConfig, o
 	return autoConvert_service_CertConfig_To_v1alpha1_CertConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_CertStatus_To_service_CertStatus(in *CertStatus, out *service.CertStatus, s conversion.Scope) error {
+	out.ShootIssuers = (*service.ShootIssuers)(unsafe.Pointer(in.ShootIssuers))
+	out.DefaultIssuer = (*service.DefaultIssuerStatus)(unsafe.Pointer(in.DefaultIssuer))
+	out.EffectiveConfiguration = (*service.EffectiveConfiguration)(unsafe.Pointer(in.EffectiveConfiguration))
+	out.ACMEAccountKeyRotations = *(*[]service.ACMEAccountKeyRotation)(unsafe.Pointer(&in.ACMEAccountKeyRotations))
+	out.SecretReplicationEnabled = in.SecretReplicationEnabled
+	out.TrustBundleCopiesEnabled = in.TrustBundleCopiesEnabled
+	return nil
+}
+
+// Convert_v1alpha1_CertStatus_To_service_CertStatus is an autogenerated conversion function.
+func Convert_v1alpha1_CertStatus_To_service_CertStatus(in *CertStatus, out *service.CertStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CertStatus_To_service_CertStatus(in, out, s)
+}
+
+func autoConvert_service_CertStatus_To_v1alpha1_CertStatus(in *service.CertStatus, out *CertStatus, s conversion.Scope) error {
+	out.ShootIssuers = (*ShootIssuers)(unsafe.Pointer(in.ShootIssuers))
+	out.DefaultIssuer = (*DefaultIssuerStatus)(unsafe.Pointer(in.DefaultIssuer))
+	out.EffectiveConfiguration = (*EffectiveConfiguration)(unsafe.Pointer(in.EffectiveConfiguration))
+	out.ACMEAccountKeyRotations = *(*[]ACMEAccountKeyRotation)(unsafe.Pointer(&in.ACMEAccountKeyRotations))
+	out.SecretReplicationEnabled = in.SecretReplicationEnabled
+	out.TrustBundleCopiesEnabled = in.TrustBundleCopiesEnabled
+	return nil
+}
+
+// Convert_service_CertStatus_To_v1alpha1_CertStatus is an autogenerated conversion function.
+func Convert_service_CertStatus_To_v1alpha1_CertStatus(in *service.CertStatus, out *CertStatus, s conversion.Scope) error {
+	return autoConvert_service_CertStatus_To_v1alpha1_CertStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_Certificate_To_service_Certificate(in *Certificate, out *service.Certificate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.SecretName = in.SecretName
+	out.SecretNamespace = (*string)(unsafe.Pointer(in.SecretNamespace))
+	out.IssuerName = (*string)(unsafe.Pointer(in.IssuerName))
+	out.PrivateKey = (*service.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
+	return nil
+}
+
+// Convert_v1alpha1_Certificate_To_service_Certificate is an autogenerated conversion function.
+func Convert_v1alpha1_Certificate_To_service_Certificate(in *Certificate, out *service.Certificate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Certificate_To_service_Certificate(in, out, s)
+}
+
+func autoConvert_service_Certificate_To_v1alpha1_Certificate(in *service.Certificate, out *Certificate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.SecretName = in.SecretName
+	out.SecretNamespace = (*string)(unsafe.Pointer(in.SecretNamespace))
+	out.IssuerName = (*string)(unsafe.Pointer(in.IssuerName))
+	out.PrivateKey = (*CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
+	return nil
+}
+
+// Convert_service_Certificate_To_v1alpha1_Certificate is an autogenerated conversion function.
+func Convert_service_Certificate_To_v1alpha1_Certificate(in *service.Certificate, out *Certificate, s conversion.Scope) error {
+	return autoConvert_service_Certificate_To_v1alpha1_Certificate(in, out, s)
+}
+
+func autoConvert_v1alpha1_CertificatePrivateKey_To_service_CertificatePrivateKey(in *CertificatePrivateKey, out *service.CertificatePrivateKey, s conversion.Scope) error {
+	out.Algorithm = (*string)(unsafe.Pointer(in.Algorithm))
+	out.Size = (*int32)(unsafe.Pointer(in.Size))
+	return nil
+}
+
+// Convert_v1alpha1_CertificatePrivateKey_To_service_CertificatePrivateKey is an autogenerated conversion function.
+func Convert_v1alpha1_CertificatePrivateKey_To_service_CertificatePrivateKey(in *CertificatePrivateKey, out *service.CertificatePrivateKey, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CertificatePrivateKey_To_service_CertificatePrivateKey(in, out, s)
+}
+
+func autoConvert_service_CertificatePrivateKey_To_v1alpha1_CertificatePrivateKey(in *service.CertificatePrivateKey, out *CertificatePrivateKey, s conversion.Scope) error {
+	out.Algorithm = (*string)(unsafe.Pointer(in.Algorithm))
+	out.Size = (*int32)(unsafe.Pointer(in.Size))
+	return nil
+}
+
+// Convert_service_CertificatePrivateKey_To_v1alpha1_CertificatePrivateKey is an autogenerated conversion function.
+func Convert_service_CertificatePrivateKey_To_v1alpha1_CertificatePrivateKey(in *service.CertificatePrivateKey, out *CertificatePrivateKey, s conversion.Scope) error {
+	return autoConvert_service_CertificatePrivateKey_To_v1alpha1_CertificatePrivateKey(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterCAIssuer_To_service_ClusterCAIssuer(in *ClusterCAIssuer, out *service.ClusterCAIssuer, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterCAIssuer_To_service_ClusterCAIssuer is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterCAIssuer_To_service_ClusterCAIssuer(in *ClusterCAIssuer, out *service.ClusterCAIssuer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterCAIssuer_To_service_ClusterCAIssuer(in, out, s)
+}
+
+func autoConvert_service_ClusterCAIssuer_To_v1alpha1_ClusterCAIssuer(in *service.ClusterCAIssuer, out *ClusterCAIssuer, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	return nil
+}
+
+// Convert_service_ClusterCAIssuer_To_v1alpha1_ClusterCAIssuer is an autogenerated conversion function.
+func Convert_service_ClusterCAIssuer_To_v1alpha1_ClusterCAIssuer(in *service.ClusterCAIssuer, out *ClusterCAIssuer, s conversion.Scope) error {
+	return autoConvert_service_ClusterCAIssuer_To_v1alpha1_ClusterCAIssuer(in, out, s)
+}
+
 func autoConvert_v1alpha1_DNSChallengeOnShoot_To_service_DNSChallengeOnShoot(in *DNSChallengeOnShoot, out *service.DNSChallengeOnShoot, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.Namespace = in.Namespace
@@ -215,6 +608,102 @@ func Convert_service_DNSSelection_To_v1alpha1_DNSSelection(in *service.DNSSelect
 	return autoConvert_service_DNSSelection_To_v1alpha1_DNSSelection(in, out, s)
 }
 
+func autoConvert_v1alpha1_DefaultIssuer_To_service_DefaultIssuer(in *DefaultIssuer, out *service.DefaultIssuer, s conversion.Scope) error {
+	out.Name = in.Name
+	out.DisableGardenIssuer = in.DisableGardenIssuer
+	return nil
+}
+
+// Convert_v1alpha1_DefaultIssuer_To_service_DefaultIssuer is an autogenerated conversion function.
+func Convert_v1alpha1_DefaultIssuer_To_service_DefaultIssuer(in *DefaultIssuer, out *service.DefaultIssuer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DefaultIssuer_To_service_DefaultIssuer(in, out, s)
+}
+
+func autoConvert_service_DefaultIssuer_To_v1alpha1_DefaultIssuer(in *service.DefaultIssuer, out *DefaultIssuer, s conversion.Scope) error {
+	out.Name = in.Name
+	out.DisableGardenIssuer = in.DisableGardenIssuer
+	return nil
+}
+
+// Convert_service_DefaultIssuer_To_v1alpha1_DefaultIssuer is an autogenerated conversion function.
+func Convert_service_DefaultIssuer_To_v1alpha1_DefaultIssuer(in *service.DefaultIssuer, out *DefaultIssuer, s conversion.Scope) error {
+	return autoConvert_service_DefaultIssuer_To_v1alpha1_DefaultIssuer(in, out, s)
+}
+
+func autoConvert_v1alpha1_DefaultIssuerStatus_To_service_DefaultIssuerStatus(in *DefaultIssuerStatus, out *service.DefaultIssuerStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Fallback = in.Fallback
+	out.Message = in.Message
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1alpha1_DefaultIssuerStatus_To_service_DefaultIssuerStatus is an autogenerated conversion function.
+func Convert_v1alpha1_DefaultIssuerStatus_To_service_DefaultIssuerStatus(in *DefaultIssuerStatus, out *service.DefaultIssuerStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DefaultIssuerStatus_To_service_DefaultIssuerStatus(in, out, s)
+}
+
+func autoConvert_service_DefaultIssuerStatus_To_v1alpha1_DefaultIssuerStatus(in *service.DefaultIssuerStatus, out *DefaultIssuerStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Fallback = in.Fallback
+	out.Message = in.Message
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_service_DefaultIssuerStatus_To_v1alpha1_DefaultIssuerStatus is an autogenerated conversion function.
+func Convert_service_DefaultIssuerStatus_To_v1alpha1_DefaultIssuerStatus(in *service.DefaultIssuerStatus, out *DefaultIssuerStatus, s conversion.Scope) error {
+	return autoConvert_service_DefaultIssuerStatus_To_v1alpha1_DefaultIssuerStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_EffectiveConfiguration_To_service_EffectiveConfiguration(in *EffectiveConfiguration, out *service.EffectiveConfiguration, s conversion.Scope) error {
+	out.Overrides = *(*[]string)(unsafe.Pointer(&in.Overrides))
+	out.ACMEServer = (*string)(unsafe.Pointer(in.ACMEServer))
+	out.PrecheckNameservers = (*string)(unsafe.Pointer(in.PrecheckNameservers))
+	out.ProxyEnabled = in.ProxyEnabled
+	return nil
+}
+
+// Convert_v1alpha1_EffectiveConfiguration_To_service_EffectiveConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_EffectiveConfiguration_To_service_EffectiveConfiguration(in *EffectiveConfiguration, out *service.EffectiveConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EffectiveConfiguration_To_service_EffectiveConfiguration(in, out, s)
+}
+
+func autoConvert_service_EffectiveConfiguration_To_v1alpha1_EffectiveConfiguration(in *service.EffectiveConfiguration, out *EffectiveConfiguration, s conversion.Scope) error {
+	out.Overrides = *(*[]string)(unsafe.Pointer(&in.Overrides))
+	out.ACMEServer = (*string)(unsafe.Pointer(in.ACMEServer))
+	out.PrecheckNameservers = (*string)(unsafe.Pointer(in.PrecheckNameservers))
+	out.ProxyEnabled = in.ProxyEnabled
+	return nil
+}
+
+// Convert_service_EffectiveConfiguration_To_v1alpha1_EffectiveConfiguration is an autogenerated conversion function.
+func Convert_service_EffectiveConfiguration_To_v1alpha1_EffectiveConfiguration(in *service.EffectiveConfiguration, out *EffectiveConfiguration, s conversion.Scope) error {
+	return autoConvert_service_EffectiveConfiguration_To_v1alpha1_EffectiveConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_IngressCertificate_To_service_IngressCertificate(in *IngressCertificate, out *service.IngressCertificate, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.AdditionalSubdomains = *(*[]string)(unsafe.Pointer(&in.AdditionalSubdomains))
+	return nil
+}
+
+// Convert_v1alpha1_IngressCertificate_To_service_IngressCertificate is an autogenerated conversion function.
+func Convert_v1alpha1_IngressCertificate_To_service_IngressCertificate(in *IngressCertificate, out *service.IngressCertificate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IngressCertificate_To_service_IngressCertificate(in, out, s)
+}
+
+func autoConvert_service_IngressCertificate_To_v1alpha1_IngressCertificate(in *service.IngressCertificate, out *IngressCertificate, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.AdditionalSubdomains = *(*[]string)(unsafe.Pointer(&in.AdditionalSubdomains))
+	return nil
+}
+
+// Convert_service_IngressCertificate_To_v1alpha1_IngressCertificate is an autogenerated conversion function.
+func Convert_service_IngressCertificate_To_v1alpha1_IngressCertificate(in *service.IngressCertificate, out *IngressCertificate, s conversion.Scope) error {
+	return autoConvert_service_IngressCertificate_To_v1alpha1_IngressCertificate(in, out, s)
+}
+
 func autoConvert_v1alpha1_IssuerConfig_To_service_IssuerConfig(in *IssuerConfig, out *service.IssuerConfig, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Server = in.Server
@@ -225,6 +714,11 @@ func autoConvert_v1alpha1_IssuerConfig_To_service_IssuerConfig(in *IssuerConfig,
 	out.SkipDNSChallengeValidation = (*bool)(unsafe.Pointer(in.SkipDNSChallengeValidation))
 	out.Domains = (*service.DNSSelection)(unsafe.Pointer(in.Domains))
 	out.PrecheckNameservers = *(*[]string)(unsafe.Pointer(&in.PrecheckNameservers))
+	out.CACertificatesResourceName = (*string)(unsafe.Pointer(in.CACertificatesResourceName))
+	out.PreferredChain = (*string)(unsafe.Pointer(in.PreferredChain))
+	out.KeyType = (*string)(unsafe.Pointer(in.KeyType))
+	out.CA = (*service.CAIssuerConfig)(unsafe.Pointer(in.CA))
+	out.CatalogIssuer = (*string)(unsafe.Pointer(in.CatalogIssuer))
 	return nil
 }
 
@@ -243,6 +737,11 @@ func autoConvert_service_IssuerConfig_To_v1alpha1_IssuerConfig(in *service.Issue
 	out.SkipDNSChallengeValidation = (*bool)(unsafe.Pointer(in.SkipDNSChallengeValidation))
 	out.Domains = (*DNSSelection)(unsafe.Pointer(in.Domains))
 	out.PrecheckNameservers = *(*[]string)(unsafe.Pointer(&in.PrecheckNameservers))
+	out.CACertificatesResourceName = (*string)(unsafe.Pointer(in.CACertificatesResourceName))
+	out.PreferredChain = (*string)(unsafe.Pointer(in.PreferredChain))
+	out.KeyType = (*string)(unsafe.Pointer(in.KeyType))
+	out.CA = (*CAIssuerConfig)(unsafe.Pointer(in.CA))
+	out.CatalogIssuer = (*string)(unsafe.Pointer(in.CatalogIssuer))
 	return nil
 }
 
@@ -251,6 +750,98 @@ func Convert_service_IssuerConfig_To_v1alpha1_IssuerConfig(in *service.IssuerCon
 	return autoConvert_service_IssuerConfig_To_v1alpha1_IssuerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_KubeAPIServerSNI_To_service_KubeAPIServerSNI(in *KubeAPIServerSNI, out *service.KubeAPIServerSNI, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.AdditionalDomains = *(*[]string)(unsafe.Pointer(&in.AdditionalDomains))
+	return nil
+}
+
+// Convert_v1alpha1_KubeAPIServerSNI_To_service_KubeAPIServerSNI is an autogenerated conversion function.
+func Convert_v1alpha1_KubeAPIServerSNI_To_service_KubeAPIServerSNI(in *KubeAPIServerSNI, out *service.KubeAPIServerSNI, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KubeAPIServerSNI_To_service_KubeAPIServerSNI(in, out, s)
+}
+
+func autoConvert_service_KubeAPIServerSNI_To_v1alpha1_KubeAPIServerSNI(in *service.KubeAPIServerSNI, out *KubeAPIServerSNI, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.AdditionalDomains = *(*[]string)(unsafe.Pointer(&in.AdditionalDomains))
+	return nil
+}
+
+// Convert_service_KubeAPIServerSNI_To_v1alpha1_KubeAPIServerSNI is an autogenerated conversion function.
+func Convert_service_KubeAPIServerSNI_To_v1alpha1_KubeAPIServerSNI(in *service.KubeAPIServerSNI, out *KubeAPIServerSNI, s conversion.Scope) error {
+	return autoConvert_service_KubeAPIServerSNI_To_v1alpha1_KubeAPIServerSNI(in, out, s)
+}
+
+func autoConvert_v1alpha1_PrivateKeyDefaults_To_service_PrivateKeyDefaults(in *PrivateKeyDefaults, out *service.PrivateKeyDefaults, s conversion.Scope) error {
+	out.Algorithm = (*string)(unsafe.Pointer(in.Algorithm))
+	out.SizeRSA = (*int32)(unsafe.Pointer(in.SizeRSA))
+	out.SizeECDSA = (*int32)(unsafe.Pointer(in.SizeECDSA))
+	return nil
+}
+
+// Convert_v1alpha1_PrivateKeyDefaults_To_service_PrivateKeyDefaults is an autogenerated conversion function.
+func Convert_v1alpha1_PrivateKeyDefaults_To_service_PrivateKeyDefaults(in *PrivateKeyDefaults, out *service.PrivateKeyDefaults, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrivateKeyDefaults_To_service_PrivateKeyDefaults(in, out, s)
+}
+
+func autoConvert_service_PrivateKeyDefaults_To_v1alpha1_PrivateKeyDefaults(in *service.PrivateKeyDefaults, out *PrivateKeyDefaults, s conversion.Scope) error {
+	out.Algorithm = (*string)(unsafe.Pointer(in.Algorithm))
+	out.SizeRSA = (*int32)(unsafe.Pointer(in.SizeRSA))
+	out.SizeECDSA = (*int32)(unsafe.Pointer(in.SizeECDSA))
+	return nil
+}
+
+// Convert_service_PrivateKeyDefaults_To_v1alpha1_PrivateKeyDefaults is an autogenerated conversion function.
+func Convert_service_PrivateKeyDefaults_To_v1alpha1_PrivateKeyDefaults(in *service.PrivateKeyDefaults, out *PrivateKeyDefaults, s conversion.Scope) error {
+	return autoConvert_service_PrivateKeyDefaults_To_v1alpha1_PrivateKeyDefaults(in, out, s)
+}
+
+func autoConvert_v1alpha1_ReplicatedSecret_To_service_ReplicatedSecret(in *ReplicatedSecret, out *service.ReplicatedSecret, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.SecretNamespace = (*string)(unsafe.Pointer(in.SecretNamespace))
+	out.NamespaceSelector = in.NamespaceSelector
+	return nil
+}
+
+// Convert_v1alpha1_ReplicatedSecret_To_service_ReplicatedSecret is an autogenerated conversion function.
+func Convert_v1alpha1_ReplicatedSecret_To_service_ReplicatedSecret(in *ReplicatedSecret, out *service.ReplicatedSecret, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ReplicatedSecret_To_service_ReplicatedSecret(in, out, s)
+}
+
+func autoConvert_service_ReplicatedSecret_To_v1alpha1_ReplicatedSecret(in *service.ReplicatedSecret, out *ReplicatedSecret, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.SecretNamespace = (*string)(unsafe.Pointer(in.SecretNamespace))
+	out.NamespaceSelector = in.NamespaceSelector
+	return nil
+}
+
+// Convert_service_ReplicatedSecret_To_v1alpha1_ReplicatedSecret is an autogenerated conversion function.
+func Convert_service_ReplicatedSecret_To_v1alpha1_ReplicatedSecret(in *service.ReplicatedSecret, out *ReplicatedSecret, s conversion.Scope) error {
+	return autoConvert_service_ReplicatedSecret_To_v1alpha1_ReplicatedSecret(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecretReplication_To_service_SecretReplication(in *SecretReplication, out *service.SecretReplication, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Secrets = *(*[]service.ReplicatedSecret)(unsafe.Pointer(&in.Secrets))
+	return nil
+}
+
+// Convert_v1alpha1_SecretReplication_To_service_SecretReplication is an autogenerated conversion function.
+func Convert_v1alpha1_SecretReplication_To_service_SecretReplication(in *SecretReplication, out *service.SecretReplication, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretReplication_To_service_SecretReplication(in, out, s)
+}
+
+func autoConvert_service_SecretReplication_To_v1alpha1_SecretReplication(in *service.SecretReplication, out *SecretReplication, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Secrets = *(*[]ReplicatedSecret)(unsafe.Pointer(&in.Secrets))
+	return nil
+}
+
+// Convert_service_SecretReplication_To_v1alpha1_SecretReplication is an autogenerated conversion function.
+func Convert_service_SecretReplication_To_v1alpha1_SecretReplication(in *service.SecretReplication, out *SecretReplication, s conversion.Scope) error {
+	return autoConvert_service_SecretReplication_To_v1alpha1_SecretReplication(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootIssuers_To_service_ShootIssuers(in *ShootIssuers, out *service.ShootIssuers, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -270,3 +861,25 @@ func autoConvert_service_ShootIssuers_To_v1alpha1_ShootIssuers(in *service.Shoot
 func Convert_service_ShootIssuers_To_v1alpha1_ShootIssuers(in *service.ShootIssuers, out *ShootIssuers, s conversion.Scope) error {
 	return autoConvert_service_ShootIssuers_To_v1alpha1_ShootIssuers(in, out, s)
 }
+
+func autoConvert_v1alpha1_TrustBundle_To_service_TrustBundle(in *TrustBundle, out *service.TrustBundle, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	return nil
+}
+
+// Convert_v1alpha1_TrustBundle_To_service_TrustBundle is an autogenerated conversion function.
+func Convert_v1alpha1_TrustBundle_To_service_TrustBundle(in *TrustBundle, out *service.TrustBundle, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TrustBundle_To_service_TrustBundle(in, out, s)
+}
+
+func autoConvert_service_TrustBundle_To_v1alpha1_TrustBundle(in *service.TrustBundle, out *TrustBundle, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	return nil
+}
+
+// Convert_service_TrustBundle_To_v1alpha1_TrustBundle is an autogenerated conversion function.
+func Convert_service_TrustBundle_To_v1alpha1_TrustBundle(in *service.TrustBundle, out *TrustBundle, s conversion.Scope) error {
+	return autoConvert_service_TrustBundle_To_v1alpha1_TrustBundle(in, out, s)
+}
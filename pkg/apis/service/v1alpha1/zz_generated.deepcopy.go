@@ -10,9 +10,27 @@
 package v1alpha1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEAccountKeyRotation) DeepCopyInto(out *ACMEAccountKeyRotation) {
+	*out = *in
+	in.LastRotationTime.DeepCopyInto(&out.LastRotationTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEAccountKeyRotation.
+func (in *ACMEAccountKeyRotation) DeepCopy() *ACMEAccountKeyRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEAccountKeyRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ACMEExternalAccountBinding) DeepCopyInto(out *ACMEExternalAccountBinding) {
 	*out = *in
@@ -29,6 +47,37 @@ func (in *ACMEExternalAccountBinding) DeepCopy() *ACMEExternalAccountBinding {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMESettings) DeepCopyInto(out *ACMESettings) {
+	*out = *in
+	if in.PropagationTimeout != nil {
+		in, out := &in.PropagationTimeout, &out.PropagationTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.PrecheckAdditionalWait != nil {
+		in, out := &in.PrecheckAdditionalWait, &out.PrecheckAdditionalWait
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DeactivateAuthorizations != nil {
+		in, out := &in.DeactivateAuthorizations, &out.DeactivateAuthorizations
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMESettings.
+func (in *ACMESettings) DeepCopy() *ACMESettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMESettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Alerting) DeepCopyInto(out *Alerting) {
 	*out = *in
@@ -50,6 +99,53 @@ func (in *Alerting) DeepCopy() *Alerting {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CAIssuerConfig) DeepCopyInto(out *CAIssuerConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAIssuerConfig.
+func (in *CAIssuerConfig) DeepCopy() *CAIssuerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CAIssuerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSRSigner) DeepCopyInto(out *CSRSigner) {
+	*out = *in
+	if in.IssuerName != nil {
+		in, out := &in.IssuerName, &out.IssuerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedDNSNames != nil {
+		in, out := &in.AllowedDNSNames, &out.AllowedDNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSRSigner.
+func (in *CSRSigner) DeepCopy() *CSRSigner {
+	if in == nil {
+		return nil
+	}
+	out := new(CSRSigner)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertConfig) DeepCopyInto(out *CertConfig) {
 	*out = *in
@@ -71,11 +167,31 @@ func (in *CertConfig) DeepCopyInto(out *CertConfig) {
 		*out = new(ShootIssuers)
 		**out = **in
 	}
+	if in.DefaultIssuer != nil {
+		in, out := &in.DefaultIssuer, &out.DefaultIssuer
+		*out = new(DefaultIssuer)
+		**out = **in
+	}
 	if in.PrecheckNameservers != nil {
 		in, out := &in.PrecheckNameservers, &out.PrecheckNameservers
 		*out = new(string)
 		**out = **in
 	}
+	if in.ACME != nil {
+		in, out := &in.ACME, &out.ACME
+		*out = new(ACMESettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateKeyDefaults != nil {
+		in, out := &in.PrivateKeyDefaults, &out.PrivateKeyDefaults
+		*out = new(PrivateKeyDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ComplianceProfile != nil {
+		in, out := &in.ComplianceProfile, &out.ComplianceProfile
+		*out = new(string)
+		**out = **in
+	}
 	if in.Alerting != nil {
 		in, out := &in.Alerting, &out.Alerting
 		*out = new(Alerting)
@@ -86,6 +202,43 @@ func (in *CertConfig) DeepCopyInto(out *CertConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ClusterCAIssuer != nil {
+		in, out := &in.ClusterCAIssuer, &out.ClusterCAIssuer
+		*out = new(ClusterCAIssuer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrustBundle != nil {
+		in, out := &in.TrustBundle, &out.TrustBundle
+		*out = new(TrustBundle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CSRSigner != nil {
+		in, out := &in.CSRSigner, &out.CSRSigner
+		*out = new(CSRSigner)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IngressCertificate != nil {
+		in, out := &in.IngressCertificate, &out.IngressCertificate
+		*out = new(IngressCertificate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]Certificate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KubeAPIServerSNI != nil {
+		in, out := &in.KubeAPIServerSNI, &out.KubeAPIServerSNI
+		*out = new(KubeAPIServerSNI)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretReplication != nil {
+		in, out := &in.SecretReplication, &out.SecretReplication
+		*out = new(SecretReplication)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -107,6 +260,141 @@ func (in *CertConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertStatus) DeepCopyInto(out *CertStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.ShootIssuers != nil {
+		in, out := &in.ShootIssuers, &out.ShootIssuers
+		*out = new(ShootIssuers)
+		**out = **in
+	}
+	if in.DefaultIssuer != nil {
+		in, out := &in.DefaultIssuer, &out.DefaultIssuer
+		*out = new(DefaultIssuerStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EffectiveConfiguration != nil {
+		in, out := &in.EffectiveConfiguration, &out.EffectiveConfiguration
+		*out = new(EffectiveConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ACMEAccountKeyRotations != nil {
+		in, out := &in.ACMEAccountKeyRotations, &out.ACMEAccountKeyRotations
+		*out = make([]ACMEAccountKeyRotation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertStatus.
+func (in *CertStatus) DeepCopy() *CertStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Certificate) DeepCopyInto(out *Certificate) {
+	*out = *in
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecretNamespace != nil {
+		in, out := &in.SecretNamespace, &out.SecretNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.IssuerName != nil {
+		in, out := &in.IssuerName, &out.IssuerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateKey != nil {
+		in, out := &in.PrivateKey, &out.PrivateKey
+		*out = new(CertificatePrivateKey)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Certificate.
+func (in *Certificate) DeepCopy() *Certificate {
+	if in == nil {
+		return nil
+	}
+	out := new(Certificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatePrivateKey) DeepCopyInto(out *CertificatePrivateKey) {
+	*out = *in
+	if in.Algorithm != nil {
+		in, out := &in.Algorithm, &out.Algorithm
+		*out = new(string)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatePrivateKey.
+func (in *CertificatePrivateKey) DeepCopy() *CertificatePrivateKey {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatePrivateKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCAIssuer) DeepCopyInto(out *ClusterCAIssuer) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCAIssuer.
+func (in *ClusterCAIssuer) DeepCopy() *ClusterCAIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCAIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSChallengeOnShoot) DeepCopyInto(out *DNSChallengeOnShoot) {
 	*out = *in
@@ -154,6 +442,91 @@ func (in *DNSSelection) DeepCopy() *DNSSelection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultIssuer) DeepCopyInto(out *DefaultIssuer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultIssuer.
+func (in *DefaultIssuer) DeepCopy() *DefaultIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultIssuerStatus) DeepCopyInto(out *DefaultIssuerStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultIssuerStatus.
+func (in *DefaultIssuerStatus) DeepCopy() *DefaultIssuerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultIssuerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveConfiguration) DeepCopyInto(out *EffectiveConfiguration) {
+	*out = *in
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ACMEServer != nil {
+		in, out := &in.ACMEServer, &out.ACMEServer
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrecheckNameservers != nil {
+		in, out := &in.PrecheckNameservers, &out.PrecheckNameservers
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveConfiguration.
+func (in *EffectiveConfiguration) DeepCopy() *EffectiveConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressCertificate) DeepCopyInto(out *IngressCertificate) {
+	*out = *in
+	if in.AdditionalSubdomains != nil {
+		in, out := &in.AdditionalSubdomains, &out.AdditionalSubdomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressCertificate.
+func (in *IngressCertificate) DeepCopy() *IngressCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IssuerConfig) DeepCopyInto(out *IssuerConfig) {
 	*out = *in
@@ -187,6 +560,31 @@ func (in *IssuerConfig) DeepCopyInto(out *IssuerConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CACertificatesResourceName != nil {
+		in, out := &in.CACertificatesResourceName, &out.CACertificatesResourceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreferredChain != nil {
+		in, out := &in.PreferredChain, &out.PreferredChain
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyType != nil {
+		in, out := &in.KeyType, &out.KeyType
+		*out = new(string)
+		**out = **in
+	}
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(CAIssuerConfig)
+		**out = **in
+	}
+	if in.CatalogIssuer != nil {
+		in, out := &in.CatalogIssuer, &out.CatalogIssuer
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -200,6 +598,103 @@ func (in *IssuerConfig) DeepCopy() *IssuerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeAPIServerSNI) DeepCopyInto(out *KubeAPIServerSNI) {
+	*out = *in
+	if in.AdditionalDomains != nil {
+		in, out := &in.AdditionalDomains, &out.AdditionalDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeAPIServerSNI.
+func (in *KubeAPIServerSNI) DeepCopy() *KubeAPIServerSNI {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeAPIServerSNI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateKeyDefaults) DeepCopyInto(out *PrivateKeyDefaults) {
+	*out = *in
+	if in.Algorithm != nil {
+		in, out := &in.Algorithm, &out.Algorithm
+		*out = new(string)
+		**out = **in
+	}
+	if in.SizeRSA != nil {
+		in, out := &in.SizeRSA, &out.SizeRSA
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SizeECDSA != nil {
+		in, out := &in.SizeECDSA, &out.SizeECDSA
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateKeyDefaults.
+func (in *PrivateKeyDefaults) DeepCopy() *PrivateKeyDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateKeyDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicatedSecret) DeepCopyInto(out *ReplicatedSecret) {
+	*out = *in
+	if in.SecretNamespace != nil {
+		in, out := &in.SecretNamespace, &out.SecretNamespace
+		*out = new(string)
+		**out = **in
+	}
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicatedSecret.
+func (in *ReplicatedSecret) DeepCopy() *ReplicatedSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicatedSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretReplication) DeepCopyInto(out *SecretReplication) {
+	*out = *in
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]ReplicatedSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReplication.
+func (in *SecretReplication) DeepCopy() *SecretReplication {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretReplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootIssuers) DeepCopyInto(out *ShootIssuers) {
 	*out = *in
@@ -215,3 +710,24 @@ func (in *ShootIssuers) DeepCopy() *ShootIssuers {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustBundle) DeepCopyInto(out *TrustBundle) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustBundle.
+func (in *TrustBundle) DeepCopy() *TrustBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustBundle)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -5,51 +5,128 @@
 package validation
 
 import (
+	"fmt"
 	"net/url"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config/validation"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
 )
 
 // ValidateCertConfig validates the passed configuration instance.
-func ValidateCertConfig(config *service.CertConfig, cluster *controller.Cluster) field.ErrorList {
+// The service configuration of the extension is needed for checks depending on the operator settings,
+// e.g. the domains allowed for the restricted default issuer.
+func ValidateCertConfig(certConfig *service.CertConfig, cluster *controller.Cluster, serviceConfig *config.Configuration) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if cluster == nil {
-		if len(config.Issuers) > 0 {
+		if len(certConfig.Issuers) > 0 {
 			allErrs = append(allErrs, field.Forbidden(field.NewPath("issuers"), "issuers are not allowed in extension on runtime cluster. You can only specify the default issuer in the 'runtimeClusterValues' of the gardener-extension-shoot-cert-service extension"))
 		}
-		if config.PrecheckNameservers != nil {
+		if certConfig.PrecheckNameservers != nil {
 			allErrs = append(allErrs, field.Forbidden(field.NewPath("precheckNameservers"), "precheckNameservers are not allowed in extension on runtime cluster."))
 		}
-		if config.DNSChallengeOnShoot != nil {
+		if certConfig.DNSChallengeOnShoot != nil {
 			allErrs = append(allErrs, field.Forbidden(field.NewPath("dnsChallengeOnShoot"), "dnsChallengeOnShoot is not allowed in extension on runtime cluster."))
 		}
-		if config.ShootIssuers != nil {
+		if certConfig.ShootIssuers != nil {
 			allErrs = append(allErrs, field.Forbidden(field.NewPath("shootIssuers"), "shootIssuers is not allowed in extension on runtime cluster."))
 		}
-		if config.Alerting != nil {
+		if certConfig.DefaultIssuer != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("defaultIssuer"), "defaultIssuer is not allowed in extension on runtime cluster."))
+		}
+		if certConfig.ACME != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("acme"), "acme is not allowed in extension on runtime cluster."))
+		}
+		if certConfig.ComplianceProfile != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("complianceProfile"), "complianceProfile is not allowed in extension on runtime cluster."))
+		}
+		if certConfig.PrivateKeyDefaults != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("privateKeyDefaults"), "privateKeyDefaults is not allowed in extension on runtime cluster."))
+		}
+		if certConfig.Alerting != nil {
 			allErrs = append(allErrs, field.Forbidden(field.NewPath("alerting"), "alerting is not allowed in extension on runtime cluster."))
 		}
+		if certConfig.ClusterCAIssuer != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("clusterCAIssuer"), "clusterCAIssuer is not allowed in extension on runtime cluster."))
+		}
+		if certConfig.TrustBundle != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("trustBundle"), "trustBundle is not allowed in extension on runtime cluster."))
+		}
+		if certConfig.CSRSigner != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("csrSigner"), "csrSigner is not allowed in extension on runtime cluster."))
+		}
+		if certConfig.IngressCertificate != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("ingressCertificate"), "ingressCertificate is not allowed in extension on runtime cluster."))
+		}
+		if len(certConfig.Certificates) > 0 {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("certificates"), "certificates are not allowed in extension on runtime cluster."))
+		}
+		if certConfig.KubeAPIServerSNI != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("kubeAPIServerSNI"), "kubeAPIServerSNI is not allowed in extension on runtime cluster."))
+		}
+		if certConfig.SecretReplication != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("secretReplication"), "secretReplication is not allowed in extension on runtime cluster."))
+		}
 		return allErrs
 	}
 
-	allErrs = append(allErrs, validateIssuers(cluster, config.Issuers, field.NewPath("issuers"))...)
+	allErrs = append(allErrs, validateIssuers(cluster, serviceConfig, certConfig.Issuers, field.NewPath("issuers"))...)
+
+	allErrs = append(allErrs, validateCustomIssuerPolicy(serviceConfig, certConfig.Issuers, field.NewPath("issuers"))...)
+
+	allErrs = append(allErrs, validateIssuerCACertificatesScope(certConfig, field.NewPath("issuers"))...)
+
+	allErrs = append(allErrs, validateApprovedPrecheckNameservers(serviceConfig, certConfig.PrecheckNameservers, field.NewPath("precheckNameservers"))...)
+
+	allErrs = append(allErrs, validateDNSChallengeOnShoot(certConfig.DNSChallengeOnShoot, field.NewPath("dnsChallengeOnShoot"))...)
+
+	allErrs = append(allErrs, validateShootIssuers(serviceConfig, certConfig.ShootIssuers, field.NewPath("shootIssuers"))...)
 
-	allErrs = append(allErrs, validateDNSChallengeOnShoot(config.DNSChallengeOnShoot, field.NewPath("dnsChallengeOnShoot"))...)
+	allErrs = append(allErrs, validateDefaultIssuer(cluster, serviceConfig, certConfig, field.NewPath("defaultIssuer"))...)
 
-	allErrs = append(allErrs, validatePrecheckNameservers(config.PrecheckNameservers, field.NewPath("precheckNameservers"))...)
+	allErrs = append(allErrs, validatePrecheckNameservers(certConfig.PrecheckNameservers, field.NewPath("precheckNameservers"))...)
+
+	allErrs = append(allErrs, validateACMESettings(serviceConfig, certConfig.ACME, field.NewPath("acme"))...)
+
+	profile := ComplianceProfile(serviceConfig, cluster, certConfig)
+	allErrs = append(allErrs, validateComplianceProfile(serviceConfig, cluster, certConfig, profile, field.NewPath("complianceProfile"))...)
+
+	allErrs = append(allErrs, validatePrivateKeyDefaults(PrivateKeyPolicies(serviceConfig, profile), certConfig.PrivateKeyDefaults, field.NewPath("privateKeyDefaults"))...)
+
+	allErrs = append(allErrs, validateIssuerKeyTypes(serviceConfig, certConfig.Issuers, PrivateKeyPolicies(serviceConfig, profile), field.NewPath("issuers"))...)
+
+	allErrs = append(allErrs, validateClusterCAIssuer(certConfig.ClusterCAIssuer, certConfig.Issuers, field.NewPath("clusterCAIssuer"))...)
+
+	allErrs = append(allErrs, validateTrustBundle(certConfig.TrustBundle, field.NewPath("trustBundle"))...)
+
+	allErrs = append(allErrs, validateCSRSigner(serviceConfig, certConfig, field.NewPath("csrSigner"))...)
+
+	allErrs = append(allErrs, validateIngressCertificate(certConfig.IngressCertificate, field.NewPath("ingressCertificate"))...)
+
+	allErrs = append(allErrs, validateCertificates(cluster, serviceConfig, certConfig, field.NewPath("certificates"))...)
+
+	allErrs = append(allErrs, validateKubeAPIServerSNI(cluster, serviceConfig, certConfig, field.NewPath("kubeAPIServerSNI"))...)
+
+	allErrs = append(allErrs, validateSecretReplication(certConfig.SecretReplication, field.NewPath("secretReplication"))...)
 
 	return allErrs
 }
 
-func validateIssuers(cluster *controller.Cluster, issuers []service.IssuerConfig, fldPath *field.Path) field.ErrorList {
+func validateIssuers(cluster *controller.Cluster, serviceConfig *config.Configuration, issuers []service.IssuerConfig, fldPath *field.Path) field.ErrorList {
 	var (
 		allErrs = field.ErrorList{}
 		names   = sets.NewString()
@@ -63,46 +140,417 @@ func validateIssuers(cluster *controller.Cluster, issuers []service.IssuerConfig
 		if names.Has(issuer.Name) {
 			allErrs = append(allErrs, field.Duplicate(indexFldPath.Child("name"), issuer.Name))
 		}
-		if _, err := url.ParseRequestURI(issuer.Server); err != nil {
-			allErrs = append(allErrs, field.Invalid(indexFldPath.Child("server"), issuer.Server, "must be a valid url"))
+		if FallbackIssuer(serviceConfig, issuer.Name) != nil {
+			allErrs = append(allErrs, field.Invalid(indexFldPath.Child("name"), issuer.Name, "name is reserved for a fallback issuer of the default issuer"))
 		}
-		if !utils.TestEmail(issuer.Email) {
-			allErrs = append(allErrs, field.Invalid(indexFldPath.Child("email"), issuer.Email, "must a valid email address"))
+		if issuer.CatalogIssuer != nil {
+			allErrs = append(allErrs, validateCatalogIssuerReference(cluster, serviceConfig, issuer, indexFldPath)...)
+		} else if issuer.CA != nil {
+			allErrs = append(allErrs, validateCAIssuer(cluster, issuer, indexFldPath)...)
+		} else {
+			allErrs = append(allErrs, validateACMEIssuer(cluster, issuer, indexFldPath)...)
+		}
+		if issuer.RequestsPerDayQuota != nil && *issuer.RequestsPerDayQuota < 1 {
+			allErrs = append(allErrs, field.Invalid(indexFldPath.Child("requestsPerDayQuota"), *issuer.RequestsPerDayQuota, "must be >= 1"))
 		}
-		if issuer.PrivateKeySecretName != nil {
-			detail := checkReferencedResource(cluster, *issuer.PrivateKeySecretName)
-			if detail != "" {
-				allErrs = append(allErrs, field.Invalid(indexFldPath.Child("privateKeySecretName"),
-					*issuer.PrivateKeySecretName, detail))
+		names.Insert(issuer.Name)
+	}
+
+	return allErrs
+}
+
+func validateACMEIssuer(cluster *controller.Cluster, issuer service.IssuerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if _, err := url.ParseRequestURI(issuer.Server); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("server"), issuer.Server, "must be a valid url"))
+	}
+	if !utils.TestEmail(issuer.Email) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("email"), issuer.Email, "must a valid email address"))
+	}
+	if issuer.PrivateKeySecretName != nil {
+		detail := checkReferencedResource(cluster, *issuer.PrivateKeySecretName)
+		if detail != "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("privateKeySecretName"),
+				*issuer.PrivateKeySecretName, detail))
+		}
+	}
+	if issuer.ExternalAccountBinding != nil {
+		if issuer.ExternalAccountBinding.KeyID == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("externalAccountBinding").Child("keyID"),
+				issuer.ExternalAccountBinding.KeyID, "must not be empty"))
+		}
+		detail := checkReferencedResource(cluster, issuer.ExternalAccountBinding.KeySecretName)
+		if detail != "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("externalAccountBinding").Child("keySecretName"),
+				issuer.ExternalAccountBinding.KeySecretName, detail))
+		}
+	}
+	if issuer.SkipDNSChallengeValidation != nil && *issuer.SkipDNSChallengeValidation &&
+		issuer.ExternalAccountBinding == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("skipDNSChallengeValidation"),
+			*issuer.SkipDNSChallengeValidation, "is only allowed for external account binding"))
+	}
+	if len(issuer.PrecheckNameservers) > 0 {
+		for j, server := range issuer.PrecheckNameservers {
+			if err := validation.ValidateNameserver(server); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("precheckNameservers").Index(j), server, err.Error()))
 			}
 		}
-		if issuer.ExternalAccountBinding != nil {
-			if issuer.ExternalAccountBinding.KeyID == "" {
-				allErrs = append(allErrs, field.Invalid(indexFldPath.Child("externalAccountBinding").Child("keyID"),
-					issuer.ExternalAccountBinding.KeyID, "must not be empty"))
+	}
+	if issuer.CACertificatesResourceName != nil {
+		detail := checkReferencedSecretOrConfigMap(cluster, *issuer.CACertificatesResourceName)
+		if detail != "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("caCertificatesResourceName"),
+				*issuer.CACertificatesResourceName, detail))
+		}
+	}
+	if issuer.PreferredChain != nil {
+		if err := validation.ValidatePreferredChain(fldPath.Child("preferredChain"), *issuer.PreferredChain); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+
+	return allErrs
+}
+
+func validateCatalogIssuerReference(cluster *controller.Cluster, serviceConfig *config.Configuration, issuer service.IssuerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if issuer.Server != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("server"), "must not be set for catalog issuer"))
+	}
+	if issuer.Email != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("email"), "must not be set for catalog issuer"))
+	}
+	if issuer.PrivateKeySecretName != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("privateKeySecretName"), "must not be set for catalog issuer"))
+	}
+	if issuer.ExternalAccountBinding != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("externalAccountBinding"), "must not be set for catalog issuer"))
+	}
+	if issuer.SkipDNSChallengeValidation != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("skipDNSChallengeValidation"), "must not be set for catalog issuer"))
+	}
+	if len(issuer.PrecheckNameservers) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("precheckNameservers"), "must not be set for catalog issuer"))
+	}
+	if issuer.CACertificatesResourceName != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("caCertificatesResourceName"), "must not be set for catalog issuer"))
+	}
+	if issuer.CA != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("ca"), "must not be set for catalog issuer"))
+	}
+
+	catalogIssuer := CatalogIssuer(serviceConfig, *issuer.CatalogIssuer)
+	if catalogIssuer == nil {
+		var names []string
+		if serviceConfig != nil {
+			for _, c := range serviceConfig.IssuerCatalog {
+				names = append(names, c.Name)
 			}
-			detail := checkReferencedResource(cluster, issuer.ExternalAccountBinding.KeySecretName)
-			if detail != "" {
-				allErrs = append(allErrs, field.Invalid(indexFldPath.Child("externalAccountBinding").Child("keySecretName"),
-					issuer.ExternalAccountBinding.KeySecretName, detail))
+		}
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("catalogIssuer"), *issuer.CatalogIssuer, names))
+	} else if !CatalogIssuerSelectsShoot(catalogIssuer, cluster) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("catalogIssuer"), fmt.Sprintf("catalog issuer %q is not available for this shoot", catalogIssuer.Name)))
+	} else if catalogIssuer.CA != nil {
+		if issuer.Domains != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("domains"), "must not be set for catalog CA issuer"))
+		}
+		if issuer.PreferredChain != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("preferredChain"), "must not be set for catalog CA issuer"))
+		}
+		if issuer.KeyType != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("keyType"), "must not be set for catalog CA issuer"))
+		}
+	} else {
+		if issuer.PreferredChain != nil {
+			if err := validation.ValidatePreferredChain(fldPath.Child("preferredChain"), *issuer.PreferredChain); err != nil {
+				allErrs = append(allErrs, err)
 			}
 		}
-		if issuer.SkipDNSChallengeValidation != nil && *issuer.SkipDNSChallengeValidation &&
-			issuer.ExternalAccountBinding == nil {
-			allErrs = append(allErrs, field.Invalid(indexFldPath.Child("skipDNSChallengeValidation"),
-				*issuer.SkipDNSChallengeValidation, "is only allowed for external account binding"))
+		if catalogIssuer.ACME.Domains != nil && len(catalogIssuer.ACME.Domains.Include) > 0 && issuer.Domains != nil {
+			for i, domain := range issuer.Domains.Include {
+				if !isInDomains(domain, catalogIssuer.ACME.Domains.Include) {
+					allErrs = append(allErrs, field.Invalid(fldPath.Child("domains", "include").Index(i), domain,
+						fmt.Sprintf("must be within the domains of the catalog issuer: %s", strings.Join(catalogIssuer.ACME.Domains.Include, ", "))))
+				}
+			}
 		}
-		if issuer.RequestsPerDayQuota != nil && *issuer.RequestsPerDayQuota < 1 {
-			allErrs = append(allErrs, field.Invalid(indexFldPath.Child("requestsPerDayQuota"), *issuer.RequestsPerDayQuota, "must be >= 1"))
+	}
+
+	return allErrs
+}
+
+// validateIssuerKeyTypes validates the key types of the ACME issuers and checks them against the private key policies.
+func validateIssuerKeyTypes(serviceConfig *config.Configuration, issuers []service.IssuerConfig, policies []*config.PrivateKeyPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, issuer := range issuers {
+		if issuer.KeyType == nil || issuer.CA != nil {
+			continue
+		}
+		if issuer.CatalogIssuer != nil {
+			if catalogIssuer := CatalogIssuer(serviceConfig, *issuer.CatalogIssuer); catalogIssuer == nil || catalogIssuer.CA != nil {
+				continue
+			}
+		}
+		if err := validation.ValidateKeyType(fldPath.Index(i).Child("keyType"), *issuer.KeyType, policies...); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+
+	return allErrs
+}
+
+// CatalogIssuerSelectsShoot checks if the issuer template of the issuer catalog is available for the shoot cluster.
+// A template without shoot and seed selectors is available for all shoots.
+func CatalogIssuerSelectsShoot(catalogIssuer *config.CatalogIssuer, cluster *controller.Cluster) bool {
+	if catalogIssuer.ShootSelector != nil {
+		if cluster == nil || cluster.Shoot == nil {
+			return false
+		}
+		selector, err := metav1.LabelSelectorAsSelector(catalogIssuer.ShootSelector)
+		if err != nil || !selector.Matches(labels.Set(cluster.Shoot.Labels)) {
+			return false
+		}
+	}
+	if catalogIssuer.SeedSelector != nil {
+		if cluster == nil || cluster.Seed == nil {
+			return false
+		}
+		selector, err := metav1.LabelSelectorAsSelector(catalogIssuer.SeedSelector)
+		if err != nil || !selector.Matches(labels.Set(cluster.Seed.Labels)) {
+			return false
+		}
+	}
+	return true
+}
+
+// CatalogIssuer returns the issuer template of the issuer catalog with the given name or nil if there is none.
+func CatalogIssuer(serviceConfig *config.Configuration, name string) *config.CatalogIssuer {
+	if serviceConfig == nil {
+		return nil
+	}
+	for i, issuer := range serviceConfig.IssuerCatalog {
+		if issuer.Name == name {
+			return &serviceConfig.IssuerCatalog[i]
+		}
+	}
+	return nil
+}
+
+// FallbackIssuer returns the fallback issuer of the default issuer with the given name or nil if there is none.
+func FallbackIssuer(serviceConfig *config.Configuration, name string) *config.CatalogIssuer {
+	if serviceConfig == nil || serviceConfig.DefaultIssuerFallback == nil {
+		return nil
+	}
+	for i, issuer := range serviceConfig.DefaultIssuerFallback.Issuers {
+		if issuer.Name == name {
+			return &serviceConfig.DefaultIssuerFallback.Issuers[i]
 		}
-		if len(issuer.PrecheckNameservers) > 0 {
+	}
+	return nil
+}
+
+// validateCustomIssuerPolicy checks the custom issuers against the custom issuer policy of the operator.
+func validateCustomIssuerPolicy(serviceConfig *config.Configuration, issuers []service.IssuerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if serviceConfig == nil || serviceConfig.CustomIssuerPolicy == nil {
+		return allErrs
+	}
+	policy := serviceConfig.CustomIssuerPolicy
+
+	if policy.MaxIssuers != nil && len(issuers) > *policy.MaxIssuers {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(issuers), *policy.MaxIssuers))
+	}
+
+	approved := approvedPrecheckNameservers(serviceConfig)
+	for i, issuer := range issuers {
+		// catalog issuers are provided by the operator
+		if issuer.CA != nil || issuer.CatalogIssuer != nil {
+			continue
+		}
+		idxPath := fldPath.Index(i)
+		if len(policy.AllowedServers) > 0 && !validation.ContainsServerURL(policy.AllowedServers, issuer.Server) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("server"), "server is not allowed by the custom issuer policy"))
+		} else if validation.ContainsServerURL(policy.DeniedServers, issuer.Server) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("server"), "server is denied by the custom issuer policy"))
+		}
+		if issuer.ExternalAccountBinding == nil && validation.ContainsServerURL(policy.ExternalAccountBindingRequiredServers, issuer.Server) {
+			allErrs = append(allErrs, field.Required(idxPath.Child("externalAccountBinding"), "external account binding is required for the server by the custom issuer policy"))
+		}
+		if policy.RestrictPrecheckNameservers {
 			for j, server := range issuer.PrecheckNameservers {
-				if err := validation.ValidateNameserver(server); err != nil {
-					allErrs = append(allErrs, field.Invalid(indexFldPath.Child("precheckNameservers").Index(j), server, err.Error()))
+				if !validation.IsApprovedNameserver(server, approved) {
+					allErrs = append(allErrs, field.Forbidden(idxPath.Child("precheckNameservers").Index(j), fmt.Sprintf("nameserver %q is not approved by the custom issuer policy", server)))
 				}
 			}
 		}
-		names.Insert(issuer.Name)
+	}
+
+	return allErrs
+}
+
+// validateApprovedPrecheckNameservers checks the comma-separated precheck nameservers against the custom issuer policy of the operator.
+func validateApprovedPrecheckNameservers(serviceConfig *config.Configuration, precheckNameservers *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if serviceConfig == nil || serviceConfig.CustomIssuerPolicy == nil || !serviceConfig.CustomIssuerPolicy.RestrictPrecheckNameservers || precheckNameservers == nil {
+		return allErrs
+	}
+
+	approved := approvedPrecheckNameservers(serviceConfig)
+	for _, server := range strings.Split(*precheckNameservers, ",") {
+		if server != "" && !validation.IsApprovedNameserver(server, approved) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("nameserver %q is not approved by the custom issuer policy", server)))
+		}
+	}
+
+	return allErrs
+}
+
+// approvedPrecheckNameservers returns the approved nameservers of the custom issuer policy and the precheck nameservers
+// of the ACME configuration.
+func approvedPrecheckNameservers(serviceConfig *config.Configuration) []string {
+	approved := slices.Clone(serviceConfig.CustomIssuerPolicy.ApprovedPrecheckNameservers)
+	if serviceConfig.ACME != nil && serviceConfig.ACME.PrecheckNameservers != nil {
+		approved = append(approved, strings.Split(*serviceConfig.ACME.PrecheckNameservers, ",")...)
+	}
+	return approved
+}
+
+func validateCAIssuer(cluster *controller.Cluster, issuer service.IssuerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if issuer.Server != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("server"), "must not be set for CA issuer"))
+	}
+	if issuer.Email != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("email"), "must not be set for CA issuer"))
+	}
+	if issuer.PrivateKeySecretName != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("privateKeySecretName"), "must not be set for CA issuer"))
+	}
+	if issuer.ExternalAccountBinding != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("externalAccountBinding"), "must not be set for CA issuer"))
+	}
+	if issuer.SkipDNSChallengeValidation != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("skipDNSChallengeValidation"), "must not be set for CA issuer"))
+	}
+	if issuer.Domains != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("domains"), "must not be set for CA issuer"))
+	}
+	if len(issuer.PrecheckNameservers) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("precheckNameservers"), "must not be set for CA issuer"))
+	}
+	if issuer.CACertificatesResourceName != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("caCertificatesResourceName"), "must not be set for CA issuer"))
+	}
+	if issuer.PreferredChain != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("preferredChain"), "must not be set for CA issuer"))
+	}
+	if issuer.KeyType != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("keyType"), "must not be set for CA issuer"))
+	}
+	if issuer.CA.SecretName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("ca", "secretName"), "must provide name of referenced CA secret"))
+	} else if detail := checkReferencedResource(cluster, issuer.CA.SecretName); detail != "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ca", "secretName"), issuer.CA.SecretName, detail))
+	}
+
+	return allErrs
+}
+
+func validateClusterCAIssuer(clusterCAIssuer *service.ClusterCAIssuer, issuers []service.IssuerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if clusterCAIssuer == nil || !clusterCAIssuer.Enabled {
+		return allErrs
+	}
+
+	name := service.DefaultClusterCAIssuerName
+	if clusterCAIssuer.Name != nil {
+		name = *clusterCAIssuer.Name
+		if name == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), name, "must not be empty"))
+		}
+	}
+	for _, issuer := range issuers {
+		if issuer.Name == name {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("name"), name))
+			break
+		}
+	}
+
+	return allErrs
+}
+
+func validateTrustBundle(trustBundle *service.TrustBundle, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if trustBundle != nil && trustBundle.NamespaceSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(trustBundle.NamespaceSelector,
+			metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("namespaceSelector"))...)
+	}
+
+	return allErrs
+}
+
+func validateCSRSigner(serviceConfig *config.Configuration, certConfig *service.CertConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	signer := certConfig.CSRSigner
+	if signer == nil || !signer.Enabled {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateSignerName(signer.SignerName, fldPath.Child("signerName"))...)
+
+	if signer.IssuerName != nil {
+		if !isCAIssuer(serviceConfig, certConfig, *signer.IssuerName) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("issuerName"), *signer.IssuerName, "must reference a CA issuer"))
+		}
+	} else if !isCAIssuer(serviceConfig, certConfig, defaultIssuerName(serviceConfig, certConfig)) {
+		allErrs = append(allErrs, field.Required(fldPath.Child("issuerName"), "must reference a CA issuer as the default issuer is no CA issuer"))
+	}
+
+	if len(signer.AllowedNamespaces) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("allowedNamespaces"), "must provide at least one namespace"))
+	}
+	for i, namespace := range signer.AllowedNamespaces {
+		for _, msg := range k8svalidation.IsDNS1123Label(namespace) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("allowedNamespaces").Index(i), namespace, msg))
+		}
+	}
+
+	if len(signer.AllowedDNSNames) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("allowedDNSNames"), "must provide at least one DNS name pattern"))
+	}
+	for i, pattern := range signer.AllowedDNSNames {
+		for _, msg := range k8svalidation.IsDNS1123Subdomain(strings.TrimPrefix(pattern, "*.")) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("allowedDNSNames").Index(i), pattern, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func validateSignerName(signerName string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	domain, path, found := strings.Cut(signerName, "/")
+	if !found || path == "" {
+		return append(allErrs, field.Invalid(fldPath, signerName, "must be in the format '<domain>/<path>'"))
+	}
+	for _, msg := range k8svalidation.IsDNS1123Subdomain(domain) {
+		allErrs = append(allErrs, field.Invalid(fldPath, signerName, msg))
+	}
+	for _, reserved := range []string{"kubernetes.io", "k8s.io"} {
+		if domain == reserved || strings.HasSuffix(domain, "."+reserved) {
+			allErrs = append(allErrs, field.Invalid(fldPath, signerName, "must not use a reserved domain of Kubernetes"))
+		}
 	}
 
 	return allErrs
@@ -123,6 +571,21 @@ func checkReferencedResource(cluster *controller.Cluster, refname string) string
 	return "referenced resource not found"
 }
 
+func checkReferencedSecretOrConfigMap(cluster *controller.Cluster, refname string) string {
+	if cluster.Shoot == nil {
+		return "shoot spec not set"
+	}
+	for _, ref := range cluster.Shoot.Spec.Resources {
+		if ref.Name == refname {
+			if ref.ResourceRef.Kind != "Secret" && ref.ResourceRef.Kind != "ConfigMap" {
+				return "expected secret or config map resource"
+			}
+			return "" // ok
+		}
+	}
+	return "referenced resource not found"
+}
+
 func validateDNSChallengeOnShoot(dnsChallenge *service.DNSChallengeOnShoot, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -135,6 +598,320 @@ func validateDNSChallengeOnShoot(dnsChallenge *service.DNSChallengeOnShoot, fldP
 	return allErrs
 }
 
+func validateShootIssuers(serviceConfig *config.Configuration, shootIssuers *service.ShootIssuers, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if shootIssuers == nil || serviceConfig == nil {
+		return allErrs
+	}
+
+	if shootIssuers.Enabled && validation.RestrictsIssuerServers(serviceConfig.CustomIssuerPolicy) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enabled"), "issuers on shoot cluster are not allowed, as the custom issuer policy restricts the issuer servers"))
+		return allErrs
+	}
+	if serviceConfig.ShootIssuers == nil {
+		return allErrs
+	}
+
+	switch serviceConfig.ShootIssuers.Policy {
+	case config.ShootIssuersPolicyForbid:
+		if shootIssuers.Enabled {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("enabled"), "issuers on shoot cluster are forbidden by the operator"))
+		}
+	case config.ShootIssuersPolicyForce:
+		if !shootIssuers.Enabled {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("enabled"), "issuers on shoot cluster are enforced by the operator"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateIssuerCACertificatesScope checks that custom root certificates are only provided if the garden issuer is disabled,
+// as the cert-controller-manager trusts them for the connections to the ACME servers of all issuers of the shoot cluster.
+func validateIssuerCACertificatesScope(certConfig *service.CertConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if certConfig.DefaultIssuer != nil && certConfig.DefaultIssuer.DisableGardenIssuer {
+		return allErrs
+	}
+	for i, issuer := range certConfig.Issuers {
+		if issuer.CACertificatesResourceName != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("caCertificatesResourceName"),
+				"custom root certificates are trusted for all ACME servers of the shoot cluster and require the garden issuer to be disabled (`defaultIssuer.disableGardenIssuer`)"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateDefaultIssuer(cluster *controller.Cluster, serviceConfig *config.Configuration, certConfig *service.CertConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	defaultIssuer := certConfig.DefaultIssuer
+	if defaultIssuer == nil {
+		return allErrs
+	}
+
+	gardenIssuerName := ""
+	if serviceConfig != nil {
+		gardenIssuerName = serviceConfig.IssuerName
+	}
+	names := sets.New[string]()
+	for _, issuer := range certConfig.Issuers {
+		names.Insert(issuer.Name)
+	}
+	if certConfig.ClusterCAIssuer != nil && certConfig.ClusterCAIssuer.Enabled {
+		names.Insert(ptr.Deref(certConfig.ClusterCAIssuer.Name, service.DefaultClusterCAIssuerName))
+	}
+	// the restricted garden issuer is not deployed for shoots without DNS domain
+	gardenIssuerAvailable := !defaultIssuer.DisableGardenIssuer && (!isRestrictedIssuer(serviceConfig) || getShootDomain(cluster) != "")
+	if gardenIssuerAvailable && gardenIssuerName != "" {
+		names.Insert(gardenIssuerName)
+	}
+
+	if defaultIssuer.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must provide the name of the default issuer"))
+	} else if !names.Has(defaultIssuer.Name) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("name"), defaultIssuer.Name, sets.List(names)))
+	}
+
+	if defaultIssuer.DisableGardenIssuer {
+		if serviceConfig == nil || !ptr.Deref(serviceConfig.AllowDisableGardenIssuer, false) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("disableGardenIssuer"), "disabling the garden issuer is not allowed by the operator"))
+		}
+	} else if isRestrictedIssuer(serviceConfig) && gardenIssuerAvailable && defaultIssuer.Name != gardenIssuerName {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("name"), "another default issuer can only be selected if the restricted garden issuer is disabled"))
+	}
+
+	return allErrs
+}
+
+const (
+	// defaultMaxPropagationTimeout limits the propagation timeout in the shoot manifest if the operator configures no maximum.
+	defaultMaxPropagationTimeout = 10 * time.Minute
+	// defaultMaxPrecheckAdditionalWait limits the precheck wait time in the shoot manifest if the operator configures no maximum.
+	defaultMaxPrecheckAdditionalWait = 2 * time.Minute
+)
+
+func validateACMESettings(serviceConfig *config.Configuration, acme *service.ACMESettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if acme == nil {
+		return allErrs
+	}
+
+	var propagationTimeoutLimits, precheckAdditionalWaitLimits *config.DurationLimits
+	if serviceConfig != nil && serviceConfig.ShootACMELimits != nil {
+		propagationTimeoutLimits = serviceConfig.ShootACMELimits.PropagationTimeout
+		precheckAdditionalWaitLimits = serviceConfig.ShootACMELimits.PrecheckAdditionalWait
+	}
+	propagationTimeoutLimits = withDefaultMax(propagationTimeoutLimits, defaultMaxPropagationTimeout)
+	precheckAdditionalWaitLimits = withDefaultMax(precheckAdditionalWaitLimits, defaultMaxPrecheckAdditionalWait)
+	allErrs = append(allErrs, validateDurationWithinLimits(acme.PropagationTimeout, propagationTimeoutLimits, false, fldPath.Child("propagationTimeout"))...)
+	allErrs = append(allErrs, validateDurationWithinLimits(acme.PrecheckAdditionalWait, precheckAdditionalWaitLimits, true, fldPath.Child("precheckAdditionalWait"))...)
+
+	return allErrs
+}
+
+func validatePrivateKeyDefaults(policies []*config.PrivateKeyPolicy, defaults *service.PrivateKeyDefaults, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if defaults == nil {
+		return allErrs
+	}
+
+	if defaults.Algorithm != nil {
+		if algorithm := *defaults.Algorithm; algorithm != "RSA" && algorithm != "ECDSA" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("algorithm"), algorithm, "algorithm must either be 'RSA' or 'ECDSA'"))
+		} else if msg := validation.PrivateKeyPolicyViolation(algorithm, 0, policies...); msg != "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("algorithm"), algorithm, msg))
+		}
+	}
+	if defaults.SizeRSA != nil {
+		if size := *defaults.SizeRSA; size != 2048 && size != 3072 && size != 4096 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sizeRSA"), size, "size for RSA algorithm must either be '2048' or '3072' or '4096'"))
+		} else if msg := validation.PrivateKeyPolicyViolation("RSA", int(size), policies...); msg != "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sizeRSA"), size, msg))
+		}
+	}
+	if defaults.SizeECDSA != nil {
+		if size := *defaults.SizeECDSA; size != 256 && size != 384 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sizeECDSA"), size, "size for ECDSA algorithm must either be '256' or '384'"))
+		} else if msg := validation.PrivateKeyPolicyViolation("ECDSA", int(size), policies...); msg != "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sizeECDSA"), size, msg))
+		}
+	}
+
+	return allErrs
+}
+
+// PrivateKeyDefaults returns the effective default algorithm and sizes for certificate private keys of a shoot cluster.
+// The defaults of the shoot manifest take precedence over the defaults of the service configuration.
+func PrivateKeyDefaults(serviceConfig *config.Configuration, certConfig *service.CertConfig) (algorithm string, sizeRSA, sizeECDSA int) {
+	algorithm, sizeRSA, sizeECDSA = config.DefaultPrivateKeyAlgorithm, config.DefaultPrivateKeySizeRSA, config.DefaultPrivateKeySizeECDSA
+	if serviceConfig != nil && serviceConfig.PrivateKeyDefaults != nil {
+		algorithm = ptr.Deref(serviceConfig.PrivateKeyDefaults.Algorithm, algorithm)
+		sizeRSA = ptr.Deref(serviceConfig.PrivateKeyDefaults.SizeRSA, sizeRSA)
+		sizeECDSA = ptr.Deref(serviceConfig.PrivateKeyDefaults.SizeECDSA, sizeECDSA)
+	}
+	if certConfig != nil && certConfig.PrivateKeyDefaults != nil {
+		algorithm = ptr.Deref(certConfig.PrivateKeyDefaults.Algorithm, algorithm)
+		if certConfig.PrivateKeyDefaults.SizeRSA != nil {
+			sizeRSA = int(*certConfig.PrivateKeyDefaults.SizeRSA)
+		}
+		if certConfig.PrivateKeyDefaults.SizeECDSA != nil {
+			sizeECDSA = int(*certConfig.PrivateKeyDefaults.SizeECDSA)
+		}
+	}
+	return
+}
+
+// PrivateKeyPolicies returns the private key policy of the service configuration and the private key restrictions
+// of the compliance profile, if any.
+func PrivateKeyPolicies(serviceConfig *config.Configuration, profile *config.ComplianceProfile) []*config.PrivateKeyPolicy {
+	var policies []*config.PrivateKeyPolicy
+	if serviceConfig != nil && serviceConfig.PrivateKeyPolicy != nil {
+		policies = append(policies, serviceConfig.PrivateKeyPolicy)
+	}
+	if profile != nil && profile.PrivateKeys != nil {
+		policies = append(policies, profile.PrivateKeys)
+	}
+	return policies
+}
+
+// ComplianceProfile returns the compliance profile applied to the shoot cluster.
+// A profile bound by the operator takes precedence over the profile selected in the shoot manifest.
+func ComplianceProfile(serviceConfig *config.Configuration, cluster *controller.Cluster, certConfig *service.CertConfig) *config.ComplianceProfile {
+	if profile := boundComplianceProfile(serviceConfig, cluster); profile != nil {
+		return profile
+	}
+	if certConfig != nil && certConfig.ComplianceProfile != nil {
+		return findComplianceProfile(serviceConfig, *certConfig.ComplianceProfile)
+	}
+	return nil
+}
+
+// boundComplianceProfile returns the compliance profile bound by the operator, i.e. the first profile selecting the seed
+// of the shoot cluster or the default profile.
+func boundComplianceProfile(serviceConfig *config.Configuration, cluster *controller.Cluster) *config.ComplianceProfile {
+	if serviceConfig == nil {
+		return nil
+	}
+	if cluster != nil && cluster.Seed != nil {
+		for i, profile := range serviceConfig.ComplianceProfiles {
+			if profile.SeedSelector == nil {
+				continue
+			}
+			selector, err := metav1.LabelSelectorAsSelector(profile.SeedSelector)
+			if err == nil && selector.Matches(labels.Set(cluster.Seed.Labels)) {
+				return &serviceConfig.ComplianceProfiles[i]
+			}
+		}
+	}
+	if serviceConfig.DefaultComplianceProfile != nil {
+		return findComplianceProfile(serviceConfig, *serviceConfig.DefaultComplianceProfile)
+	}
+	return nil
+}
+
+func findComplianceProfile(serviceConfig *config.Configuration, name string) *config.ComplianceProfile {
+	if serviceConfig == nil {
+		return nil
+	}
+	for i, profile := range serviceConfig.ComplianceProfiles {
+		if profile.Name == name {
+			return &serviceConfig.ComplianceProfiles[i]
+		}
+	}
+	return nil
+}
+
+func validateComplianceProfile(serviceConfig *config.Configuration, cluster *controller.Cluster, certConfig *service.CertConfig, profile *config.ComplianceProfile, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if name := certConfig.ComplianceProfile; name != nil {
+		if bound := boundComplianceProfile(serviceConfig, cluster); bound != nil && bound.Name != *name {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("compliance profile %q is bound to the shoot cluster by the operator", bound.Name)))
+		} else if findComplianceProfile(serviceConfig, *name) == nil {
+			var names []string
+			if serviceConfig != nil {
+				for _, p := range serviceConfig.ComplianceProfiles {
+					names = append(names, p.Name)
+				}
+			}
+			allErrs = append(allErrs, field.NotSupported(fldPath, *name, names))
+		}
+	}
+
+	if profile == nil {
+		return allErrs
+	}
+
+	for i, issuer := range certConfig.Issuers {
+		idxPath := field.NewPath("issuers").Index(i)
+		if issuer.CatalogIssuer != nil {
+			catalogIssuer := CatalogIssuer(serviceConfig, *issuer.CatalogIssuer)
+			if catalogIssuer == nil || catalogIssuer.ACME == nil {
+				continue
+			}
+			if !validation.IsIssuerServerAllowed(profile, catalogIssuer.ACME.Server) {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("catalogIssuer"), fmt.Sprintf("server of catalog issuer is not allowed by compliance profile %q", profile.Name)))
+			}
+			if ptr.Deref(catalogIssuer.ACME.SkipDNSChallengeValidation, false) && !profile.AllowSkipDNSChallengeValidation {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("catalogIssuer"), fmt.Sprintf("catalog issuer skipping the DNS challenge validation is not allowed by compliance profile %q", profile.Name)))
+			}
+			continue
+		}
+		if issuer.CA != nil {
+			continue
+		}
+		if !validation.IsIssuerServerAllowed(profile, issuer.Server) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("server"), fmt.Sprintf("server is not allowed by compliance profile %q", profile.Name)))
+		}
+		if ptr.Deref(issuer.SkipDNSChallengeValidation, false) && !profile.AllowSkipDNSChallengeValidation {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("skipDNSChallengeValidation"), fmt.Sprintf("skipping the DNS challenge validation is not allowed by compliance profile %q", profile.Name)))
+		}
+	}
+	if certConfig.ShootIssuers != nil && certConfig.ShootIssuers.Enabled && len(profile.AllowedIssuerServers) > 0 {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("shootIssuers", "enabled"), fmt.Sprintf("issuers on shoot cluster are not allowed, as compliance profile %q restricts the issuer servers", profile.Name)))
+	}
+
+	return allErrs
+}
+
+// validateDurationWithinLimits checks that the duration is positive (or zero if allowed) and within the limits of the operator.
+// withDefaultMax returns the given limits with the built-in maximum if the operator configures no maximum.
+func withDefaultMax(limits *config.DurationLimits, maxDuration time.Duration) *config.DurationLimits {
+	if limits != nil && limits.Max != nil {
+		return limits
+	}
+	result := &config.DurationLimits{Max: &metav1.Duration{Duration: maxDuration}}
+	if limits != nil {
+		result.Min = limits.Min
+	}
+	return result
+}
+
+func validateDurationWithinLimits(duration *metav1.Duration, limits *config.DurationLimits, allowZero bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if duration == nil {
+		return allErrs
+	}
+
+	switch {
+	case duration.Duration < 0 || (duration.Duration == 0 && !allowZero):
+		allErrs = append(allErrs, field.Invalid(fldPath, duration.Duration.String(), "must be positive"))
+	case limits != nil && limits.Min != nil && duration.Duration < limits.Min.Duration:
+		allErrs = append(allErrs, field.Invalid(fldPath, duration.Duration.String(), "must be at least "+limits.Min.Duration.String()))
+	case limits != nil && limits.Max != nil && duration.Duration > limits.Max.Duration:
+		allErrs = append(allErrs, field.Invalid(fldPath, duration.Duration.String(), "must not exceed "+limits.Max.Duration.String()))
+	}
+
+	return allErrs
+}
+
 func validatePrecheckNameservers(precheckNameservers *string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if precheckNameservers != nil {
@@ -151,3 +928,316 @@ func validatePrecheckNameservers(precheckNameservers *string, fldPath *field.Pat
 	}
 	return allErrs
 }
+
+func validateIngressCertificate(ingressCertificate *service.IngressCertificate, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ingressCertificate == nil {
+		return allErrs
+	}
+
+	subdomains := sets.New("ingress")
+	for i, subdomain := range ingressCertificate.AdditionalSubdomains {
+		idxPath := fldPath.Child("additionalSubdomains").Index(i)
+		for _, msg := range k8svalidation.IsDNS1123Subdomain(subdomain) {
+			allErrs = append(allErrs, field.Invalid(idxPath, subdomain, msg))
+		}
+		if subdomains.Has(subdomain) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, subdomain))
+		}
+		subdomains.Insert(subdomain)
+	}
+
+	return allErrs
+}
+
+func validateCertificates(cluster *controller.Cluster, serviceConfig *config.Configuration, certConfig *service.CertConfig, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+		secrets = sets.New[string]()
+	)
+
+	restricted := isRestrictedIssuer(serviceConfig)
+	restrictedDomains := RestrictedDomains(serviceConfig, cluster)
+	profile := ComplianceProfile(serviceConfig, cluster, certConfig)
+	policies := PrivateKeyPolicies(serviceConfig, profile)
+
+	for i, cert := range certConfig.Certificates {
+		idxPath := fldPath.Index(i)
+		namespace := ptr.Deref(cert.SecretNamespace, metav1.NamespaceDefault)
+
+		for _, msg := range k8svalidation.IsDNS1123Subdomain(cert.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), cert.Name, msg))
+		}
+		if names.Has(namespace + "/" + cert.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), cert.Name))
+		}
+		names.Insert(namespace + "/" + cert.Name)
+
+		for _, msg := range k8svalidation.IsDNS1123Subdomain(cert.SecretName) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("secretName"), cert.SecretName, msg))
+		}
+		if secrets.Has(namespace + "/" + cert.SecretName) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("secretName"), cert.SecretName))
+		}
+		secrets.Insert(namespace + "/" + cert.SecretName)
+		if cert.SecretNamespace != nil {
+			for _, msg := range k8svalidation.IsDNS1123Label(*cert.SecretNamespace) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("secretNamespace"), *cert.SecretNamespace, msg))
+			}
+		}
+
+		if cert.IssuerName != nil && *cert.IssuerName == "" {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("issuerName"), *cert.IssuerName, "must not be empty"))
+		}
+		defaultIssuer := (cert.IssuerName == nil && isGardenIssuerDefault(serviceConfig, certConfig)) ||
+			(cert.IssuerName != nil && serviceConfig != nil && *cert.IssuerName == serviceConfig.IssuerName)
+
+		if len(cert.DNSNames) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("dnsNames"), "at least one DNS name is required"))
+		}
+		for j, dnsName := range cert.DNSNames {
+			dnsNamePath := idxPath.Child("dnsNames").Index(j)
+			for _, msg := range k8svalidation.IsDNS1123Subdomain(strings.TrimPrefix(dnsName, "*.")) {
+				allErrs = append(allErrs, field.Invalid(dnsNamePath, dnsName, msg))
+			}
+			if restricted && defaultIssuer && !isInDomains(dnsName, restrictedDomains) {
+				allErrs = append(allErrs, field.Invalid(dnsNamePath, dnsName, "must be a subdomain of the shoot domains for the restricted default issuer"))
+			}
+		}
+
+		allErrs = append(allErrs, validateCertificatePrivateKey(serviceConfig, certConfig, policies, cert.PrivateKey, idxPath.Child("privateKey"))...)
+
+		if cert.Duration != nil && cert.Duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("duration"), cert.Duration.Duration.String(), "must be positive"))
+		} else if cert.Duration != nil && profile != nil && profile.MaxCertificateDuration != nil && cert.Duration.Duration > profile.MaxCertificateDuration.Duration {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("duration"), cert.Duration.Duration.String(),
+				fmt.Sprintf("must not exceed %s by compliance profile %q", profile.MaxCertificateDuration.Duration, profile.Name)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCertificatePrivateKey(serviceConfig *config.Configuration, certConfig *service.CertConfig, policies []*config.PrivateKeyPolicy, privateKey *service.CertificatePrivateKey, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if privateKey == nil {
+		return allErrs
+	}
+
+	algorithm := ptr.Deref(privateKey.Algorithm, "")
+	if privateKey.Algorithm != nil && algorithm != "RSA" && algorithm != "ECDSA" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("algorithm"), algorithm, "algorithm must either be 'RSA' or 'ECDSA'"))
+	}
+	if privateKey.Size != nil {
+		size := *privateKey.Size
+		switch algorithm {
+		case "ECDSA":
+			if size != 256 && size != 384 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("size"), size, "size for ECDSA algorithm must either be '256' or '384'"))
+			}
+		case "RSA":
+			if size != 2048 && size != 3072 && size != 4096 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("size"), size, "size for RSA algorithm must either be '2048' or '3072' or '4096'"))
+			}
+		default:
+			if size != 256 && size != 384 && size != 2048 && size != 3072 && size != 4096 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("size"), size, "size must either be '256' or '384' for ECDSA or '2048', '3072' or '4096' for RSA"))
+			}
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	defaultAlgorithm, sizeRSA, sizeECDSA := PrivateKeyDefaults(serviceConfig, certConfig)
+	algorithm = ptr.Deref(privateKey.Algorithm, defaultAlgorithm)
+	size := sizeRSA
+	if algorithm == "ECDSA" {
+		size = sizeECDSA
+	}
+	if privateKey.Size != nil {
+		size = int(*privateKey.Size)
+	}
+	if msg := validation.PrivateKeyPolicyViolation(algorithm, size, policies...); msg != "" {
+		allErrs = append(allErrs, field.Invalid(fldPath, fmt.Sprintf("%s/%d", algorithm, size), msg))
+	}
+
+	return allErrs
+}
+
+func validateKubeAPIServerSNI(cluster *controller.Cluster, serviceConfig *config.Configuration, certConfig *service.CertConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	sni := certConfig.KubeAPIServerSNI
+	if sni == nil || !sni.Enabled {
+		return allErrs
+	}
+
+	shootDomain := getShootDomain(cluster)
+	if shootDomain == "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enabled"), "shoot has no DNS domain"))
+	}
+	domains := sets.New[string]()
+	for i, domain := range sni.AdditionalDomains {
+		idxPath := fldPath.Child("additionalDomains").Index(i)
+		for _, msg := range k8svalidation.IsDNS1123Subdomain(domain) {
+			allErrs = append(allErrs, field.Invalid(idxPath, domain, msg))
+		}
+		if domains.Has(domain) || domain == "api."+shootDomain {
+			allErrs = append(allErrs, field.Duplicate(idxPath, domain))
+		}
+		domains.Insert(domain)
+		if isRestrictedIssuer(serviceConfig) && isGardenIssuerDefault(serviceConfig, certConfig) && shootDomain != "" &&
+			!isInDomains(domain, RestrictedDomains(serviceConfig, cluster)) {
+			allErrs = append(allErrs, field.Invalid(idxPath, domain, "must be a subdomain of the shoot domains for the restricted default issuer"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateSecretReplication(replication *service.SecretReplication, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if replication == nil {
+		return allErrs
+	}
+
+	secrets := sets.New[string]()
+	for i, secret := range replication.Secrets {
+		idxPath := fldPath.Child("secrets").Index(i)
+		namespace := ptr.Deref(secret.SecretNamespace, metav1.NamespaceDefault)
+
+		for _, msg := range k8svalidation.IsDNS1123Subdomain(secret.SecretName) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("secretName"), secret.SecretName, msg))
+		}
+		if secrets.Has(namespace + "/" + secret.SecretName) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("secretName"), secret.SecretName))
+		}
+		secrets.Insert(namespace + "/" + secret.SecretName)
+		if secret.SecretNamespace != nil {
+			for _, msg := range k8svalidation.IsDNS1123Label(*secret.SecretNamespace) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("secretNamespace"), *secret.SecretNamespace, msg))
+			}
+		}
+
+		if len(secret.NamespaceSelector.MatchLabels) == 0 && len(secret.NamespaceSelector.MatchExpressions) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("namespaceSelector"), "selector must not be empty"))
+		}
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&secret.NamespaceSelector,
+			metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("namespaceSelector"))...)
+	}
+
+	return allErrs
+}
+
+func isRestrictedIssuer(serviceConfig *config.Configuration) bool {
+	return serviceConfig != nil && ptr.Deref(serviceConfig.RestrictIssuer, false)
+}
+
+// isGardenIssuerDefault checks if the issuer of the service configuration is the default issuer of the shoot cluster.
+func isGardenIssuerDefault(serviceConfig *config.Configuration, certConfig *service.CertConfig) bool {
+	return certConfig.DefaultIssuer == nil || (serviceConfig != nil && certConfig.DefaultIssuer.Name == serviceConfig.IssuerName)
+}
+
+// defaultIssuerName returns the name of the effective default issuer of the shoot cluster.
+func defaultIssuerName(serviceConfig *config.Configuration, certConfig *service.CertConfig) string {
+	if certConfig.DefaultIssuer != nil && certConfig.DefaultIssuer.Name != "" {
+		return certConfig.DefaultIssuer.Name
+	}
+	if serviceConfig != nil {
+		return serviceConfig.IssuerName
+	}
+	return ""
+}
+
+// isCAIssuer checks if the issuer with the given name is deployed as CA issuer for the shoot cluster.
+func isCAIssuer(serviceConfig *config.Configuration, certConfig *service.CertConfig, name string) bool {
+	gardenIssuerDisabled := certConfig.DefaultIssuer != nil && certConfig.DefaultIssuer.DisableGardenIssuer
+	if serviceConfig != nil && name == serviceConfig.IssuerName && !gardenIssuerDisabled {
+		return serviceConfig.CA != nil
+	}
+	for _, issuer := range certConfig.Issuers {
+		if issuer.Name != name {
+			continue
+		}
+		if issuer.CatalogIssuer != nil {
+			catalogIssuer := CatalogIssuer(serviceConfig, *issuer.CatalogIssuer)
+			return catalogIssuer != nil && catalogIssuer.CA != nil
+		}
+		return issuer.CA != nil
+	}
+	return certConfig.ClusterCAIssuer != nil && certConfig.ClusterCAIssuer.Enabled &&
+		ptr.Deref(certConfig.ClusterCAIssuer.Name, service.DefaultClusterCAIssuerName) == name
+}
+
+func getShootDomain(cluster *controller.Cluster) string {
+	if cluster.Shoot == nil || cluster.Shoot.Spec.DNS == nil {
+		return ""
+	}
+	return ptr.Deref(cluster.Shoot.Spec.DNS.Domain, "")
+}
+
+// RestrictedDomains returns the domain ranges of the restricted default issuer for the shoot cluster.
+// Besides the shoot domain, they contain the included domains of the DNS providers of the shoot, if enabled by the operator.
+// An included domain is skipped if it is excluded by the DNS provider itself. As excluded subdomains of an included domain
+// cannot be expressed as domain range, an included domain with excluded subdomains is skipped, too.
+func RestrictedDomains(serviceConfig *config.Configuration, cluster *controller.Cluster) []string {
+	var domains []string
+	if shootDomain := getShootDomain(cluster); shootDomain != "" {
+		domains = append(domains, strings.ToLower(shootDomain))
+	}
+	if serviceConfig == nil || !ptr.Deref(serviceConfig.RestrictedDomainsFromDNSProviders, false) ||
+		cluster == nil || cluster.Shoot == nil || cluster.Shoot.Spec.DNS == nil {
+		return domains
+	}
+
+	for _, provider := range cluster.Shoot.Spec.DNS.Providers {
+		if provider.Domains == nil {
+			continue
+		}
+		for _, include := range provider.Domains.Include {
+			include = strings.ToLower(include)
+			if include == "" || isInDomains(include, domains) || isInDomains(include, provider.Domains.Exclude) ||
+				hasSubdomainIn(include, provider.Domains.Exclude) {
+				continue
+			}
+			domains = append(domains, include)
+		}
+	}
+	return domains
+}
+
+// hasSubdomainIn checks if one of the DNS names is the domain itself or a subdomain of it.
+func hasSubdomainIn(domain string, dnsNames []string) bool {
+	for _, dnsName := range dnsNames {
+		if isInDomain(dnsName, domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// isInDomains checks if the DNS name (which may be a wildcard) is in one of the domains.
+func isInDomains(dnsName string, domains []string) bool {
+	for _, domain := range domains {
+		if isInDomain(dnsName, domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// isInDomain checks if the DNS name (which may be a wildcard) is the domain itself or a subdomain of it.
+func isInDomain(dnsName, domain string) bool {
+	if domain == "" {
+		return false
+	}
+	name := strings.ToLower(strings.TrimPrefix(dnsName, "*."))
+	domain = strings.ToLower(domain)
+	return name == domain || strings.HasSuffix(name, "."+domain)
+}
@@ -5,6 +5,9 @@
 package validation_test
 
 import (
+	"strings"
+	"time"
+
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	. "github.com/onsi/ginkgo/v2"
@@ -12,8 +15,10 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/validation"
 )
@@ -28,9 +33,14 @@ var _ = Describe("Validation", func() {
 		empty        = ""
 		nameservers  = "10.0.0.53:53,1.1.1.1,[2001:0db8:85a3:08d3::0370:7344]:8080,dns.server.test,dns.server.test.:53"
 		invalid_ns   = "dns.server.te%st,dns.server.test:123456"
-		cluster      = &controller.Cluster{
+		extConfig    = &config.Configuration{
+			IssuerName:     "garden",
+			RestrictIssuer: &tru,
+		}
+		cluster = &controller.Cluster{
 			Shoot: &gardencorev1beta1.Shoot{
 				Spec: gardencorev1beta1.ShootSpec{
+					DNS: &gardencorev1beta1.DNS{Domain: new("foo.example.com")},
 					Resources: []gardencorev1beta1.NamedResourceReference{
 						{
 							Name: "testref",
@@ -40,6 +50,14 @@ var _ = Describe("Validation", func() {
 								APIVersion: "v1",
 							},
 						},
+						{
+							Name: "testcm",
+							ResourceRef: autoscalingv1.CrossVersionObjectReference{
+								Kind:       "ConfigMap",
+								Name:       "referenced-configmap",
+								APIVersion: "v1",
+							},
+						},
 					},
 				},
 			},
@@ -47,7 +65,7 @@ var _ = Describe("Validation", func() {
 	)
 	DescribeTable("#ValidateCertConfigShoot",
 		func(config service.CertConfig, match gomegatypes.GomegaMatcher) {
-			err := validation.ValidateCertConfig(&config, cluster)
+			err := validation.ValidateCertConfig(&config, cluster, extConfig)
 			Expect(err).To(match)
 		},
 		Entry("No issuers", service.CertConfig{}, BeEmpty()),
@@ -245,59 +263,214 @@ var _ = Describe("Validation", func() {
 				"Field": Equal("issuers[0].precheckNameservers[3]"),
 			})),
 		)),
-		Entry("DNSChallengeOnShoot", service.CertConfig{
-			DNSChallengeOnShoot: &service.DNSChallengeOnShoot{
-				Enabled:   true,
-				Namespace: "",
+		Entry("Valid configuration with preferred chain", service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				{
+					Name:           "issuer",
+					Server:         "https://acme-v02.api.letsencrypt.org/directory",
+					Email:          "john@example.com",
+					PreferredChain: new("ISRG Root X1"),
+				},
+			},
+		}, BeEmpty()),
+		Entry("Valid configuration with key type", service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				{
+					Name:    "issuer",
+					Server:  "https://acme-v02.api.letsencrypt.org/directory",
+					Email:   "john@example.com",
+					KeyType: new("ECDSA256"),
+				},
+			},
+		}, BeEmpty()),
+		Entry("Invalid configuration with unsupported key type", service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				{
+					Name:    "issuer",
+					Server:  "https://acme-v02.api.letsencrypt.org/directory",
+					Email:   "john@example.com",
+					KeyType: new("RSA1024"),
+				},
 			},
 		}, ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeRequired),
-				"Field": Equal("dnsChallengeOnShoot.namespace"),
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("issuers[0].keyType"),
 			})),
 		)),
-		Entry("Valid DNSChallengeOnShoot", service.CertConfig{
-			DNSChallengeOnShoot: &service.DNSChallengeOnShoot{
-				Enabled:   true,
-				Namespace: "kube-system",
+		Entry("Invalid configuration with invalid preferred chains", service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				{
+					Name:           "issuer",
+					Server:         "https://acme-v02.api.letsencrypt.org/directory",
+					Email:          "john@example.com",
+					PreferredChain: new(" "),
+				},
+				{
+					Name:           "issuer2",
+					Server:         "https://acme-v02.api.letsencrypt.org/directory",
+					Email:          "john@example.com",
+					PreferredChain: new(strings.Repeat("x", 65)),
+				},
+			},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("issuers[0].preferredChain"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeTooLong),
+				"Field": Equal("issuers[1].preferredChain"),
+			})),
+		)),
+		Entry("Valid CA issuer", service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				{
+					Name: "issuer",
+					CA: &service.CAIssuerConfig{
+						SecretName: testref,
+					},
+				},
 			},
 		}, BeEmpty()),
-		Entry("Valid PrecheckNameservers", service.CertConfig{
-			PrecheckNameservers: &nameservers,
+		Entry("Invalid CA issuer with unmatched secret ref", service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				{
+					Name: "issuer",
+					CA: &service.CAIssuerConfig{
+						SecretName: wrongtestref,
+					},
+				},
+			},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("issuers[0].ca.secretName"),
+			})),
+		)),
+		Entry("Invalid CA issuer with ACME fields", service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				{
+					Name:                       "issuer",
+					Server:                     "https://acme-v02.api.letsencrypt.org/directory",
+					Email:                      "john@example.com",
+					PrivateKeySecretName:       &testref,
+					SkipDNSChallengeValidation: &tru,
+					Domains:                    &service.DNSSelection{Include: []string{"example.com"}},
+					PrecheckNameservers:        []string{"8.8.8.8"},
+					PreferredChain:             new("ISRG Root X1"),
+					KeyType:                    new("RSA3072"),
+					CA:                         &service.CAIssuerConfig{},
+				},
+			},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].keyType"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].server"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].email"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].privateKeySecretName"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].skipDNSChallengeValidation"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].domains"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].precheckNameservers"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].preferredChain"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("issuers[0].ca.secretName"),
+			})),
+		)),
+		Entry("Valid ClusterCAIssuer", service.CertConfig{
+			ClusterCAIssuer: &service.ClusterCAIssuer{Enabled: true},
 		}, BeEmpty()),
-		Entry("Invalid PrecheckNameservers", service.CertConfig{
-			PrecheckNameservers: &invalid_ns,
+		Entry("Invalid ClusterCAIssuer with empty name", service.CertConfig{
+			ClusterCAIssuer: &service.ClusterCAIssuer{Enabled: true, Name: &empty},
 		}, ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":     Equal(field.ErrorTypeInvalid),
-				"Field":    Equal("precheckNameservers[0]"),
-				"BadValue": Equal("dns.server.te%st"),
-				"Detail":   ContainSubstring("'dns.server.te%st' is no valid IP address or domain name"),
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("clusterCAIssuer.name"),
 			})),
+		)),
+		Entry("Invalid ClusterCAIssuer with name of custom issuer", service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				{
+					Name:   "cluster-ca",
+					Server: "https://acme-v02.api.letsencrypt.org/directory",
+					Email:  "john@example.com",
+				},
+			},
+			ClusterCAIssuer: &service.ClusterCAIssuer{Enabled: true},
+		}, ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":     Equal(field.ErrorTypeInvalid),
-				"Field":    Equal("precheckNameservers[1]"),
-				"BadValue": Equal("dns.server.test:123456"),
-				"Detail":   Equal("'123456' is no valid port number"),
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("clusterCAIssuer.name"),
 			})),
 		)),
-		Entry("Empty PrecheckNameservers", service.CertConfig{
-			PrecheckNameservers: &empty,
+		Entry("Valid TrustBundle", service.CertConfig{
+			TrustBundle: &service.TrustBundle{
+				Enabled:           true,
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"trust": "true"}},
+			},
+		}, BeEmpty()),
+		Entry("Invalid TrustBundle namespace selector", service.CertConfig{
+			TrustBundle: &service.TrustBundle{
+				Enabled: true,
+				NamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "trust", Operator: "foo"},
+				}},
+			},
 		}, ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":   Equal(field.ErrorTypeInvalid),
-				"Field":  Equal("precheckNameservers"),
-				"Detail": Equal("must contain at least one DNS server address"),
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("trustBundle.namespaceSelector.matchExpressions[0].operator"),
 			})),
 		)),
-	)
-	DescribeTable("#ValidateCertConfigRuntimeCluster",
-		func(config service.CertConfig, match gomegatypes.GomegaMatcher) {
-			err := validation.ValidateCertConfig(&config, nil)
-			Expect(err).To(match)
-		},
-		Entry("No issuers", service.CertConfig{}, BeEmpty()),
-		Entry("Unsupported issuer", service.CertConfig{
+		Entry("Valid CSRSigner", service.CertConfig{
+			ClusterCAIssuer: &service.ClusterCAIssuer{Enabled: true},
+			CSRSigner: &service.CSRSigner{
+				Enabled:           true,
+				SignerName:        "example.com/internal",
+				IssuerName:        new("cluster-ca"),
+				AllowedNamespaces: []string{"istio-system"},
+				AllowedDNSNames:   []string{"*.svc.cluster.local", "my.example.com"},
+			},
+		}, BeEmpty()),
+		Entry("CSRSigner with implicit garden issuer as default issuer", service.CertConfig{
+			ClusterCAIssuer: &service.ClusterCAIssuer{Enabled: true},
+			CSRSigner: &service.CSRSigner{
+				Enabled:           true,
+				SignerName:        "example.com/internal",
+				AllowedNamespaces: []string{"istio-system"},
+				AllowedDNSNames:   []string{"my.example.com"},
+			},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("csrSigner.issuerName"),
+			})),
+		)),
+		Entry("Invalid CSRSigner", service.CertConfig{
 			Issuers: []service.IssuerConfig{
 				{
 					Name:   "issuer",
@@ -305,47 +478,1155 @@ var _ = Describe("Validation", func() {
 					Email:  "john@example.com",
 				},
 			},
+			CSRSigner: &service.CSRSigner{
+				Enabled:           true,
+				SignerName:        "kubernetes.io/internal",
+				IssuerName:        new("issuer"),
+				AllowedNamespaces: []string{"Invalid_NS"},
+				AllowedDNSNames:   []string{"*.invalid_domain"},
+			},
 		}, ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeForbidden),
-				"Field": Equal("issuers"),
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("csrSigner.signerName"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("csrSigner.issuerName"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("csrSigner.allowedNamespaces[0]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("csrSigner.allowedDNSNames[0]"),
 			})),
 		)),
-		Entry("Unsupported DNSChallengeOnShoot", service.CertConfig{
-			DNSChallengeOnShoot: &service.DNSChallengeOnShoot{
-				Enabled:   true,
-				Namespace: "kube-system",
+		Entry("Valid IngressCertificate", service.CertConfig{
+			IngressCertificate: &service.IngressCertificate{
+				Enabled:              true,
+				AdditionalSubdomains: []string{"apps", "internal.apps"},
+			},
+		}, BeEmpty()),
+		Entry("Invalid IngressCertificate subdomains", service.CertConfig{
+			IngressCertificate: &service.IngressCertificate{
+				Enabled:              true,
+				AdditionalSubdomains: []string{"*.apps", "ingress", "apps", "apps"},
 			},
 		}, ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeForbidden),
-				"Field": Equal("dnsChallengeOnShoot"),
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("ingressCertificate.additionalSubdomains[0]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("ingressCertificate.additionalSubdomains[1]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("ingressCertificate.additionalSubdomains[3]"),
 			})),
 		)),
-		Entry("Unsupported PrecheckNameservers", service.CertConfig{
-			PrecheckNameservers: &nameservers,
+		Entry("Valid certificates", service.CertConfig{
+			Certificates: []service.Certificate{
+				{
+					Name:       "wildcard",
+					DNSNames:   []string{"*.apps.foo.example.com", "foo.example.com"},
+					SecretName: "wildcard-tls",
+					PrivateKey: &service.CertificatePrivateKey{Algorithm: new("ECDSA"), Size: new(int32(384))},
+					Duration:   &metav1.Duration{Duration: 720 * time.Hour},
+				},
+				{
+					Name:            "external",
+					DNSNames:        []string{"www.example.org"},
+					SecretName:      "external-tls",
+					SecretNamespace: new("web"),
+					IssuerName:      new("custom"),
+				},
+			},
+		}, BeEmpty()),
+		Entry("Invalid certificates", service.CertConfig{
+			Certificates: []service.Certificate{
+				{
+					Name:       "wildcard",
+					DNSNames:   []string{"*.apps.foo.example.com", "www.example.org"},
+					SecretName: "wildcard-tls",
+					IssuerName: new("garden"),
+					PrivateKey: &service.CertificatePrivateKey{Algorithm: new("RSA"), Size: new(int32(384))},
+				},
+				{
+					Name:            "wildcard",
+					SecretName:      "wildcard-tls",
+					SecretNamespace: new("Invalid_NS"),
+					Duration:        &metav1.Duration{},
+				},
+				{
+					Name:       "wildcard",
+					DNSNames:   []string{"invalid_name.foo.example.com"},
+					SecretName: "wildcard-tls",
+					PrivateKey: &service.CertificatePrivateKey{Algorithm: new("DSA")},
+				},
+			},
 		}, ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeForbidden),
-				"Field": Equal("precheckNameservers"),
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("certificates[0].dnsNames[1]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("certificates[0].privateKey.size"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("certificates[1].secretNamespace"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("certificates[1].dnsNames"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("certificates[1].duration"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("certificates[2].name"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("certificates[2].secretName"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("certificates[2].dnsNames[0]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("certificates[2].privateKey.algorithm"),
 			})),
 		)),
-		Entry("Unsupported ShootIssuer", service.CertConfig{
-			ShootIssuers: &service.ShootIssuers{
+		Entry("Valid KubeAPIServerSNI", service.CertConfig{
+			KubeAPIServerSNI: &service.KubeAPIServerSNI{
+				Enabled:           true,
+				AdditionalDomains: []string{"k8s.foo.example.com"},
+			},
+		}, BeEmpty()),
+		Entry("Invalid KubeAPIServerSNI", service.CertConfig{
+			KubeAPIServerSNI: &service.KubeAPIServerSNI{
+				Enabled:           true,
+				AdditionalDomains: []string{"api.foo.example.com", "k8s.example.org", "*.foo.example.com"},
+			},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("kubeAPIServerSNI.additionalDomains[0]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("kubeAPIServerSNI.additionalDomains[1]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("kubeAPIServerSNI.additionalDomains[2]"),
+			})),
+		)),
+		Entry("Valid SecretReplication", service.CertConfig{
+			SecretReplication: &service.SecretReplication{
+				Enabled: true,
+				Secrets: []service.ReplicatedSecret{
+					{SecretName: "wildcard-tls", NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}},
+					{SecretName: "wildcard-tls", SecretNamespace: new("web"), NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}},
+				},
+			},
+		}, BeEmpty()),
+		Entry("Invalid SecretReplication", service.CertConfig{
+			SecretReplication: &service.SecretReplication{
 				Enabled: true,
+				Secrets: []service.ReplicatedSecret{
+					{SecretName: "wildcard-tls", NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}},
+					{SecretName: "wildcard-tls", SecretNamespace: new("default")},
+					{SecretName: "Invalid", NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "a/b"}}},
+				},
 			},
 		}, ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeForbidden),
-				"Field": Equal("shootIssuers"),
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("secretReplication.secrets[1].secretName"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("secretReplication.secrets[1].namespaceSelector"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("secretReplication.secrets[2].secretName"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("secretReplication.secrets[2].namespaceSelector.matchLabels"),
 			})),
 		)),
-		Entry("Unsupported Alerting", service.CertConfig{
-			Alerting: &service.Alerting{},
+		Entry("Incomplete CSRSigner", service.CertConfig{
+			CSRSigner: &service.CSRSigner{
+				Enabled:    true,
+				SignerName: "example.com",
+			},
 		}, ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeForbidden),
-				"Field": Equal("alerting"),
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("csrSigner.signerName"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("csrSigner.issuerName"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("csrSigner.allowedNamespaces"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("csrSigner.allowedDNSNames"),
+			})),
+		)),
+		Entry("DNSChallengeOnShoot", service.CertConfig{
+			DNSChallengeOnShoot: &service.DNSChallengeOnShoot{
+				Enabled:   true,
+				Namespace: "",
+			},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("dnsChallengeOnShoot.namespace"),
+			})),
+		)),
+		Entry("Valid DNSChallengeOnShoot", service.CertConfig{
+			DNSChallengeOnShoot: &service.DNSChallengeOnShoot{
+				Enabled:   true,
+				Namespace: "kube-system",
+			},
+		}, BeEmpty()),
+		Entry("Valid PrecheckNameservers", service.CertConfig{
+			PrecheckNameservers: &nameservers,
+		}, BeEmpty()),
+		Entry("Invalid PrecheckNameservers", service.CertConfig{
+			PrecheckNameservers: &invalid_ns,
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("precheckNameservers[0]"),
+				"BadValue": Equal("dns.server.te%st"),
+				"Detail":   ContainSubstring("'dns.server.te%st' is no valid IP address or domain name"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("precheckNameservers[1]"),
+				"BadValue": Equal("dns.server.test:123456"),
+				"Detail":   Equal("'123456' is no valid port number"),
+			})),
+		)),
+		Entry("Empty PrecheckNameservers", service.CertConfig{
+			PrecheckNameservers: &empty,
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("precheckNameservers"),
+				"Detail": Equal("must contain at least one DNS server address"),
+			})),
+		)),
+	)
+	DescribeTable("#ValidateCertConfigShootIssuersPolicy",
+		func(policy config.ShootIssuersPolicy, shootIssuers *service.ShootIssuers, match gomegatypes.GomegaMatcher) {
+			serviceConfig := extConfig.DeepCopy()
+			serviceConfig.ShootIssuers = &config.ShootIssuers{Policy: policy}
+			err := validation.ValidateCertConfig(&service.CertConfig{ShootIssuers: shootIssuers}, cluster, serviceConfig)
+			Expect(err).To(match)
+		},
+		Entry("Default policy allows enabling", config.ShootIssuersPolicyDefault, &service.ShootIssuers{Enabled: true}, BeEmpty()),
+		Entry("Default policy allows disabling", config.ShootIssuersPolicyDefault, &service.ShootIssuers{Enabled: false}, BeEmpty()),
+		Entry("Forbid policy allows disabling", config.ShootIssuersPolicyForbid, &service.ShootIssuers{Enabled: false}, BeEmpty()),
+		Entry("Forbid policy rejects enabling", config.ShootIssuersPolicyForbid, &service.ShootIssuers{Enabled: true}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("shootIssuers.enabled"),
+			})),
+		)),
+		Entry("Force policy allows enabling", config.ShootIssuersPolicyForce, &service.ShootIssuers{Enabled: true}, BeEmpty()),
+		Entry("Force policy rejects disabling", config.ShootIssuersPolicyForce, &service.ShootIssuers{Enabled: false}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("shootIssuers.enabled"),
+			})),
+		)),
+		Entry("Force policy without shoot setting", config.ShootIssuersPolicyForce, nil, BeEmpty()),
+	)
+	DescribeTable("#ValidateCertConfigDefaultIssuer",
+		func(allowDisable, restricted bool, certConfig service.CertConfig, match gomegatypes.GomegaMatcher) {
+			serviceConfig := extConfig.DeepCopy()
+			serviceConfig.AllowDisableGardenIssuer = &allowDisable
+			serviceConfig.RestrictIssuer = &restricted
+			err := validation.ValidateCertConfig(&certConfig, cluster, serviceConfig)
+			Expect(err).To(match)
+		},
+		Entry("Garden issuer as default", false, true, service.CertConfig{
+			DefaultIssuer: &service.DefaultIssuer{Name: "garden"},
+		}, BeEmpty()),
+		Entry("Custom issuer as default", false, false, service.CertConfig{
+			Issuers:       []service.IssuerConfig{{Name: "own", Server: "https://acme.example.com/directory", Email: "foo@example.com"}},
+			DefaultIssuer: &service.DefaultIssuer{Name: "own"},
+		}, BeEmpty()),
+		Entry("CA issuer as default for the CSR signer", false, false, service.CertConfig{
+			Issuers:       []service.IssuerConfig{{Name: "corporate", CA: &service.CAIssuerConfig{SecretName: "testref"}}},
+			DefaultIssuer: &service.DefaultIssuer{Name: "corporate"},
+			CSRSigner: &service.CSRSigner{
+				Enabled:           true,
+				SignerName:        "example.com/internal",
+				AllowedNamespaces: []string{"istio-system"},
+				AllowedDNSNames:   []string{"my.example.com"},
+			},
+		}, BeEmpty()),
+		Entry("CA certificates with disabled garden issuer", true, false, service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				{
+					Name:                       "issuer",
+					Server:                     "https://acme.internal.example.com/directory",
+					Email:                      "john@example.com",
+					CACertificatesResourceName: new("testcm"),
+				},
+				{
+					Name:                       "issuer2",
+					Server:                     "https://acme.internal.example.com/directory",
+					Email:                      "john@example.com",
+					CACertificatesResourceName: &testref,
+				},
+			},
+			DefaultIssuer: &service.DefaultIssuer{Name: "issuer", DisableGardenIssuer: true},
+		}, BeEmpty()),
+		Entry("CA certificates with unmatched ref", true, false, service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				{
+					Name:                       "issuer",
+					Server:                     "https://acme.internal.example.com/directory",
+					Email:                      "john@example.com",
+					CACertificatesResourceName: &wrongtestref,
+				},
+			},
+			DefaultIssuer: &service.DefaultIssuer{Name: "issuer", DisableGardenIssuer: true},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("issuers[0].caCertificatesResourceName"),
+			})),
+		)),
+		Entry("CA certificates with enabled garden issuer", true, false, service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				{
+					Name:                       "issuer",
+					Server:                     "https://acme.internal.example.com/directory",
+					Email:                      "john@example.com",
+					CACertificatesResourceName: new("testcm"),
+				},
+			},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].caCertificatesResourceName"),
+			})),
+		)),
+		Entry("Cluster CA issuer as default", false, false, service.CertConfig{
+			ClusterCAIssuer: &service.ClusterCAIssuer{Enabled: true},
+			DefaultIssuer:   &service.DefaultIssuer{Name: "cluster-ca"},
+		}, BeEmpty()),
+		Entry("Unknown default issuer", false, false, service.CertConfig{
+			DefaultIssuer: &service.DefaultIssuer{Name: "unknown"},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("defaultIssuer.name"),
+			})),
+		)),
+		Entry("Missing name of default issuer", false, false, service.CertConfig{
+			DefaultIssuer: &service.DefaultIssuer{},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("defaultIssuer.name"),
+			})),
+		)),
+		Entry("Custom issuer as default with restricted garden issuer", false, true, service.CertConfig{
+			Issuers:       []service.IssuerConfig{{Name: "own", Server: "https://acme.example.com/directory", Email: "foo@example.com"}},
+			DefaultIssuer: &service.DefaultIssuer{Name: "own"},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("defaultIssuer.name"),
+			})),
+		)),
+		Entry("Disabled garden issuer if allowed", true, true, service.CertConfig{
+			Issuers:       []service.IssuerConfig{{Name: "own", Server: "https://acme.example.com/directory", Email: "foo@example.com"}},
+			DefaultIssuer: &service.DefaultIssuer{Name: "own", DisableGardenIssuer: true},
+		}, BeEmpty()),
+		Entry("Disabled garden issuer if not allowed", false, false, service.CertConfig{
+			Issuers:       []service.IssuerConfig{{Name: "own", Server: "https://acme.example.com/directory", Email: "foo@example.com"}},
+			DefaultIssuer: &service.DefaultIssuer{Name: "own", DisableGardenIssuer: true},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("defaultIssuer.disableGardenIssuer"),
+			})),
+		)),
+		Entry("Disabled garden issuer as default", true, false, service.CertConfig{
+			DefaultIssuer: &service.DefaultIssuer{Name: "garden", DisableGardenIssuer: true},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("defaultIssuer.name"),
+			})),
+		)),
+		Entry("Certificate outside of shoot domain with custom default issuer", true, true, service.CertConfig{
+			Issuers:       []service.IssuerConfig{{Name: "own", Server: "https://acme.example.com/directory", Email: "foo@example.com"}},
+			DefaultIssuer: &service.DefaultIssuer{Name: "own", DisableGardenIssuer: true},
+			Certificates:  []service.Certificate{{Name: "cert", SecretName: "cert", DNSNames: []string{"www.example.org"}}},
+		}, BeEmpty()),
+	)
+	DescribeTable("#ValidateCertConfigACMESettingsWithoutLimits",
+		func(acme *service.ACMESettings, match gomegatypes.GomegaMatcher) {
+			err := validation.ValidateCertConfig(&service.CertConfig{ACME: acme}, cluster, extConfig)
+			Expect(err).To(match)
+		},
+		Entry("Settings within built-in limits", &service.ACMESettings{
+			PropagationTimeout:     &metav1.Duration{Duration: 10 * time.Minute},
+			PrecheckAdditionalWait: &metav1.Duration{Duration: 2 * time.Minute},
+		}, BeEmpty()),
+		Entry("Durations above built-in maximum", &service.ACMESettings{
+			PropagationTimeout:     &metav1.Duration{Duration: time.Hour},
+			PrecheckAdditionalWait: &metav1.Duration{Duration: 3 * time.Minute},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("acme.propagationTimeout"),
+				"Detail": Equal("must not exceed 10m0s"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("acme.precheckAdditionalWait"),
+				"Detail": Equal("must not exceed 2m0s"),
+			})),
+		)),
+	)
+	DescribeTable("#ValidateCertConfigACMESettings",
+		func(acme *service.ACMESettings, match gomegatypes.GomegaMatcher) {
+			serviceConfig := extConfig.DeepCopy()
+			serviceConfig.ShootACMELimits = &config.ShootACMELimits{
+				PropagationTimeout:     &config.DurationLimits{Min: &metav1.Duration{Duration: time.Minute}, Max: &metav1.Duration{Duration: 10 * time.Minute}},
+				PrecheckAdditionalWait: &config.DurationLimits{Max: &metav1.Duration{Duration: time.Minute}},
+			}
+			err := validation.ValidateCertConfig(&service.CertConfig{ACME: acme}, cluster, serviceConfig)
+			Expect(err).To(match)
+		},
+		Entry("Settings within limits", &service.ACMESettings{
+			PropagationTimeout:       &metav1.Duration{Duration: 5 * time.Minute},
+			PrecheckAdditionalWait:   &metav1.Duration{},
+			DeactivateAuthorizations: &tru,
+		}, BeEmpty()),
+		Entry("Propagation timeout below minimum", &service.ACMESettings{
+			PropagationTimeout: &metav1.Duration{Duration: 30 * time.Second},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("acme.propagationTimeout"),
+				"Detail": Equal("must be at least 1m0s"),
+			})),
+		)),
+		Entry("Durations above maximum", &service.ACMESettings{
+			PropagationTimeout:     &metav1.Duration{Duration: time.Hour},
+			PrecheckAdditionalWait: &metav1.Duration{Duration: 2 * time.Minute},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("acme.propagationTimeout"),
+				"Detail": Equal("must not exceed 10m0s"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("acme.precheckAdditionalWait"),
+				"Detail": Equal("must not exceed 1m0s"),
+			})),
+		)),
+		Entry("Negative precheck wait", &service.ACMESettings{
+			PrecheckAdditionalWait: &metav1.Duration{Duration: -time.Second},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("acme.precheckAdditionalWait"),
+			})),
+		)),
+	)
+
+	DescribeTable("#ValidateCertConfigPrivateKeyPolicy",
+		func(certConfig service.CertConfig, match gomegatypes.GomegaMatcher) {
+			serviceConfig := extConfig.DeepCopy()
+			serviceConfig.PrivateKeyDefaults = &config.PrivateKeyDefaults{SizeRSA: new(4096)}
+			serviceConfig.PrivateKeyPolicy = &config.PrivateKeyPolicy{
+				AllowedAlgorithms: []string{"RSA", "ECDSA"},
+				MinSizeRSA:        new(3072),
+				MinSizeECDSA:      new(384),
+			}
+			Expect(validation.ValidateCertConfig(&certConfig, cluster, serviceConfig)).To(match)
+		},
+		Entry("Defaults within policy", service.CertConfig{
+			PrivateKeyDefaults: &service.PrivateKeyDefaults{Algorithm: new("ECDSA"), SizeRSA: new(int32(3072)), SizeECDSA: new(int32(384))},
+		}, BeEmpty()),
+		Entry("Defaults violating policy", service.CertConfig{
+			PrivateKeyDefaults: &service.PrivateKeyDefaults{SizeRSA: new(int32(2048)), SizeECDSA: new(int32(256))},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("privateKeyDefaults.sizeRSA"),
+				"Detail": Equal("size for RSA algorithm must be at least '3072' by the private key policy"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("privateKeyDefaults.sizeECDSA"),
+				"Detail": Equal("size for ECDSA algorithm must be at least '384' by the private key policy"),
+			})),
+		)),
+		Entry("Invalid defaults", service.CertConfig{
+			PrivateKeyDefaults: &service.PrivateKeyDefaults{Algorithm: new("DSA"), SizeRSA: new(int32(1024))},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("privateKeyDefaults.algorithm"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("privateKeyDefaults.sizeRSA"),
+			})),
+		)),
+		Entry("Certificate using the default size of the shoot", service.CertConfig{
+			PrivateKeyDefaults: &service.PrivateKeyDefaults{SizeECDSA: new(int32(384))},
+			Certificates: []service.Certificate{{
+				Name:       "foo",
+				DNSNames:   []string{"bar.foo.example.com"},
+				SecretName: "foo-tls",
+				PrivateKey: &service.CertificatePrivateKey{Algorithm: new("ECDSA")},
+			}},
+		}, BeEmpty()),
+		Entry("Issuer key types violating policy", service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				{Name: "rsa", Server: "https://acme-v02.api.letsencrypt.org/directory", Email: "john@example.com", KeyType: new("RSA2048")},
+				{Name: "ecdsa", Server: "https://acme-v02.api.letsencrypt.org/directory", Email: "john@example.com", KeyType: new("ECDSA384")},
+			},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("issuers[0].keyType"),
+				"Detail": Equal("size for RSA algorithm must be at least '3072' by the private key policy"),
+			})),
+		)),
+		Entry("Certificate violating policy", service.CertConfig{
+			Certificates: []service.Certificate{{
+				Name:       "foo",
+				DNSNames:   []string{"bar.foo.example.com"},
+				SecretName: "foo-tls",
+				PrivateKey: &service.CertificatePrivateKey{Size: new(int32(2048))},
+			}},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("certificates[0].privateKey"),
+				"BadValue": Equal("RSA/2048"),
+				"Detail":   Equal("size for RSA algorithm must be at least '3072' by the private key policy"),
+			})),
+		)),
+	)
+
+	DescribeTable("#ValidateCertConfigCatalogIssuer",
+		func(issuer service.IssuerConfig, match gomegatypes.GomegaMatcher) {
+			serviceConfig := extConfig.DeepCopy()
+			serviceConfig.IssuerCatalog = []config.CatalogIssuer{
+				{
+					Name: "letsencrypt-staging",
+					ACME: &config.CatalogACME{
+						Email:   "operator@example.com",
+						Server:  "https://acme-staging-v02.api.letsencrypt.org/directory",
+						Domains: &config.DNSSelection{Include: []string{"example.com"}},
+					},
+				},
+				{
+					Name: "corp-ca",
+					CA:   &config.CA{Certificate: "cert", CertificateKey: "key"},
+				},
+				{
+					Name:          "team-ca",
+					ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+					CA:            &config.CA{Certificate: "cert", CertificateKey: "key"},
+				},
+			}
+			certConfig := &service.CertConfig{Issuers: []service.IssuerConfig{issuer}}
+			Expect(validation.ValidateCertConfig(certConfig, cluster, serviceConfig)).To(match)
+		},
+		Entry("ACME catalog issuer", service.IssuerConfig{
+			Name:                "staging",
+			CatalogIssuer:       new("letsencrypt-staging"),
+			RequestsPerDayQuota: &one,
+			Domains:             &service.DNSSelection{Include: []string{"foo.example.com"}},
+			PreferredChain:      new("(STAGING) Pretend Pear X1"),
+		}, BeEmpty()),
+		Entry("CA catalog issuer", service.IssuerConfig{
+			Name:          "corp",
+			CatalogIssuer: new("corp-ca"),
+		}, BeEmpty()),
+		Entry("ACME catalog issuer with domains outside of the template domains", service.IssuerConfig{
+			Name:          "staging",
+			CatalogIssuer: new("letsencrypt-staging"),
+			Domains:       &service.DNSSelection{Include: []string{"foo.example.com", "foo.example.org"}},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("issuers[0].domains.include[1]"),
+				"BadValue": Equal("foo.example.org"),
+			})),
+		)),
+		Entry("Catalog issuer not selecting the shoot", service.IssuerConfig{
+			Name:          "team",
+			CatalogIssuer: new("team-ca"),
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].catalogIssuer"),
+			})),
+		)),
+		Entry("Unknown catalog issuer", service.IssuerConfig{
+			Name:          "unknown",
+			CatalogIssuer: new("unknown"),
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("issuers[0].catalogIssuer"),
+			})),
+		)),
+		Entry("Catalog issuer with own settings", service.IssuerConfig{
+			Name:                       "corp",
+			CatalogIssuer:              new("corp-ca"),
+			Server:                     "https://acme.example.com/directory",
+			Email:                      "john@example.com",
+			PrivateKeySecretName:       &testref,
+			ExternalAccountBinding:     &service.ACMEExternalAccountBinding{KeyID: "mykey", KeySecretName: testref},
+			SkipDNSChallengeValidation: &tru,
+			PrecheckNameservers:        []string{"1.1.1.1"},
+			Domains:                    &service.DNSSelection{Include: []string{"foo.example.com"}},
+			PreferredChain:             new("ISRG Root X1"),
+			CA:                         &service.CAIssuerConfig{SecretName: testref},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].server"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].email"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].privateKeySecretName"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].externalAccountBinding"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].skipDNSChallengeValidation"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].precheckNameservers"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].ca"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].domains"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].preferredChain"),
+			})),
+		)),
+	)
+	It("should allow catalog issuers selecting the shoot and its seed", func() {
+		serviceConfig := extConfig.DeepCopy()
+		serviceConfig.IssuerCatalog = []config.CatalogIssuer{
+			{
+				Name:          "team-ca",
+				ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+				SeedSelector:  &metav1.LabelSelector{MatchLabels: map[string]string{"region": "eu"}},
+				CA:            &config.CA{Certificate: "cert", CertificateKey: "key"},
+			},
+		}
+		certConfig := &service.CertConfig{Issuers: []service.IssuerConfig{{Name: "team", CatalogIssuer: new("team-ca")}}}
+		selectedCluster := &controller.Cluster{
+			Shoot: cluster.Shoot.DeepCopy(),
+			Seed:  &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"region": "eu"}}},
+		}
+		selectedCluster.Shoot.Labels = map[string]string{"team": "a"}
+		Expect(validation.ValidateCertConfig(certConfig, selectedCluster, serviceConfig)).To(BeEmpty())
+
+		selectedCluster.Seed.Labels = map[string]string{"region": "us"}
+		Expect(validation.ValidateCertConfig(certConfig, selectedCluster, serviceConfig)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].catalogIssuer"),
+			})),
+		))
+	})
+
+	It("should forbid issuers with the name of a fallback issuer", func() {
+		serviceConfig := extConfig.DeepCopy()
+		serviceConfig.DefaultIssuerFallback = &config.DefaultIssuerFallback{
+			Issuers: []config.CatalogIssuer{{Name: "zerossl"}},
+		}
+		certConfig := &service.CertConfig{Issuers: []service.IssuerConfig{
+			{Name: "zerossl", Server: "https://acme.zerossl.com/v2/DV90", Email: "john@example.com"},
+		}}
+		Expect(validation.ValidateCertConfig(certConfig, cluster, serviceConfig)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("issuers[0].name"),
+				"Detail": Equal("name is reserved for a fallback issuer of the default issuer"),
+			})),
+		))
+	})
+	DescribeTable("#ValidateCertConfigCustomIssuerPolicy",
+		func(certConfig service.CertConfig, match gomegatypes.GomegaMatcher) {
+			serviceConfig := extConfig.DeepCopy()
+			serviceConfig.ACME = &config.ACME{PrecheckNameservers: new("10.0.0.53,10.0.1.53:5353")}
+			serviceConfig.CustomIssuerPolicy = &config.CustomIssuerPolicy{
+				DeniedServers:                         []string{"https://acme-staging-v02.api.letsencrypt.org/directory"},
+				MaxIssuers:                            new(2),
+				ExternalAccountBindingRequiredServers: []string{"https://acme.example.com/directory"},
+				RestrictPrecheckNameservers:           true,
+				ApprovedPrecheckNameservers:           []string{"dns.example.com"},
+			}
+			Expect(validation.ValidateCertConfig(&certConfig, cluster, serviceConfig)).To(match)
+		},
+		Entry("Compliant issuers", service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				{
+					Name:                "issuer",
+					Server:              "https://acme-v02.api.letsencrypt.org/directory",
+					Email:               "john@example.com",
+					PrecheckNameservers: []string{"dns.example.com:53", "10.0.1.53:5353"},
+				},
+				{
+					Name:                   "eab-issuer",
+					Server:                 "https://acme.example.com/directory",
+					Email:                  "john@example.com",
+					ExternalAccountBinding: &service.ACMEExternalAccountBinding{KeyID: "mykey", KeySecretName: testref},
+				},
+			},
+			PrecheckNameservers: new("10.0.0.53:53"),
+		}, BeEmpty()),
+		Entry("Violating issuers", service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				{
+					Name:                "issuer",
+					Server:              "HTTPS://ACME-Staging-v02.api.letsencrypt.org:443/directory/",
+					Email:               "john@example.com",
+					PrecheckNameservers: []string{"dns.example.com", "8.8.8.8"},
+				},
+				{
+					Name:   "eab-issuer",
+					Server: "https://acme.example.com/directory/",
+					Email:  "john@example.com",
+				},
+				{
+					Name: "ca-issuer",
+					CA:   &service.CAIssuerConfig{SecretName: testref},
+				},
+			},
+			PrecheckNameservers: new("10.0.0.53,1.1.1.1"),
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeTooMany),
+				"Field": Equal("issuers"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("issuers[0].server"),
+				"Detail": Equal("server is denied by the custom issuer policy"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("issuers[0].precheckNameservers[1]"),
+				"Detail": Equal(`nameserver "8.8.8.8" is not approved by the custom issuer policy`),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("issuers[1].externalAccountBinding"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("precheckNameservers"),
+				"Detail": Equal(`nameserver "1.1.1.1" is not approved by the custom issuer policy`),
+			})),
+		)),
+	)
+
+	It("should reject servers not allowed by the custom issuer policy", func() {
+		serviceConfig := extConfig.DeepCopy()
+		serviceConfig.CustomIssuerPolicy = &config.CustomIssuerPolicy{
+			AllowedServers: []string{"https://acme.example.com/directory"},
+		}
+		certConfig := &service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				{
+					Name:   "issuer",
+					Server: "https://acme.example.com/directory",
+					Email:  "john@example.com",
+				},
+				{
+					Name:   "other-issuer",
+					Server: "https://acme.example.org/directory",
+					Email:  "john@example.com",
+				},
+				{
+					Name:   "normalized-issuer",
+					Server: "https://ACME.example.com:443/directory/",
+					Email:  "john@example.com",
+				},
+			},
+		}
+		Expect(validation.ValidateCertConfig(certConfig, cluster, serviceConfig)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("issuers[1].server"),
+				"Detail": Equal("server is not allowed by the custom issuer policy"),
+			})),
+		))
+	})
+
+	It("should forbid issuers on the shoot cluster if the custom issuer policy restricts the servers", func() {
+		serviceConfig := extConfig.DeepCopy()
+		serviceConfig.CustomIssuerPolicy = &config.CustomIssuerPolicy{
+			DeniedServers: []string{"https://acme.example.org/directory"},
+		}
+		certConfig := &service.CertConfig{ShootIssuers: &service.ShootIssuers{Enabled: true}}
+		Expect(validation.ValidateCertConfig(certConfig, cluster, serviceConfig)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("shootIssuers.enabled"),
+			})),
+		))
+	})
+
+	DescribeTable("#ValidateCertConfigComplianceProfile",
+		func(seedLabels map[string]string, certConfig service.CertConfig, match gomegatypes.GomegaMatcher) {
+			serviceConfig := extConfig.DeepCopy()
+			serviceConfig.ComplianceProfiles = []config.ComplianceProfile{
+				{
+					Name:                   "regulated",
+					SeedSelector:           &metav1.LabelSelector{MatchLabels: map[string]string{"compliance": "regulated"}},
+					PrivateKeys:            &config.PrivateKeyPolicy{AllowedAlgorithms: []string{"ECDSA"}},
+					AllowedIssuerServers:   []string{"https://acme.example.com/directory"},
+					MaxCertificateDuration: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+				},
+				{
+					Name:                            "relaxed",
+					AllowSkipDNSChallengeValidation: true,
+				},
+			}
+			shootCluster := &controller.Cluster{
+				Shoot: cluster.Shoot.DeepCopy(),
+				Seed:  &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Labels: seedLabels}},
+			}
+			Expect(validation.ValidateCertConfig(&certConfig, shootCluster, serviceConfig)).To(match)
+		},
+		Entry("No profile", nil, service.CertConfig{
+			Issuers: []service.IssuerConfig{{
+				Name:                       "issuer",
+				Server:                     "https://acme-v02.api.letsencrypt.org/directory",
+				Email:                      "john@example.com",
+				ExternalAccountBinding:     &service.ACMEExternalAccountBinding{KeyID: "mykey", KeySecretName: testref},
+				SkipDNSChallengeValidation: &tru,
+			}},
+		}, BeEmpty()),
+		Entry("Profile selected in shoot manifest", nil, service.CertConfig{
+			ComplianceProfile: new("relaxed"),
+			Issuers: []service.IssuerConfig{{
+				Name:                       "issuer",
+				Server:                     "https://acme-v02.api.letsencrypt.org/directory",
+				Email:                      "john@example.com",
+				ExternalAccountBinding:     &service.ACMEExternalAccountBinding{KeyID: "mykey", KeySecretName: testref},
+				SkipDNSChallengeValidation: &tru,
+			}},
+		}, BeEmpty()),
+		Entry("Unknown profile selected in shoot manifest", nil, service.CertConfig{
+			ComplianceProfile: new("unknown"),
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("complianceProfile"),
+			})),
+		)),
+		Entry("Other profile selected than bound to the seed", map[string]string{"compliance": "regulated"}, service.CertConfig{
+			ComplianceProfile: new("relaxed"),
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("complianceProfile"),
+				"Detail": Equal(`compliance profile "regulated" is bound to the shoot cluster by the operator`),
+			})),
+		)),
+		Entry("Compliant with profile bound to the seed", map[string]string{"compliance": "regulated"}, service.CertConfig{
+			Issuers: []service.IssuerConfig{{
+				Name:   "issuer",
+				Server: "https://acme.example.com/directory",
+				Email:  "john@example.com",
+			}},
+			PrivateKeyDefaults: &service.PrivateKeyDefaults{Algorithm: new("ECDSA")},
+			Certificates: []service.Certificate{{
+				Name:       "foo",
+				DNSNames:   []string{"bar.foo.example.com"},
+				SecretName: "foo-tls",
+				Duration:   &metav1.Duration{Duration: 30 * 24 * time.Hour},
+			}},
+		}, BeEmpty()),
+		Entry("Violating profile bound to the seed", map[string]string{"compliance": "regulated"}, service.CertConfig{
+			Issuers: []service.IssuerConfig{{
+				Name:                       "issuer",
+				Server:                     "https://acme-v02.api.letsencrypt.org/directory",
+				Email:                      "john@example.com",
+				ExternalAccountBinding:     &service.ACMEExternalAccountBinding{KeyID: "mykey", KeySecretName: testref},
+				SkipDNSChallengeValidation: &tru,
+			}},
+			ShootIssuers:       &service.ShootIssuers{Enabled: true},
+			PrivateKeyDefaults: &service.PrivateKeyDefaults{Algorithm: new("RSA")},
+			Certificates: []service.Certificate{{
+				Name:       "foo",
+				DNSNames:   []string{"bar.foo.example.com"},
+				SecretName: "foo-tls",
+				Duration:   &metav1.Duration{Duration: 90 * 24 * time.Hour},
+			}},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("issuers[0].server"),
+				"Detail": Equal(`server is not allowed by compliance profile "regulated"`),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers[0].skipDNSChallengeValidation"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("shootIssuers.enabled"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("privateKeyDefaults.algorithm"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("certificates[0].duration"),
+				"Detail": Equal(`must not exceed 720h0m0s by compliance profile "regulated"`),
+			})),
+		)),
+	)
+	It("should allow another default issuer for a shoot without domain", func() {
+		shootCluster := &controller.Cluster{Shoot: cluster.Shoot.DeepCopy()}
+		shootCluster.Shoot.Spec.DNS = nil
+		certConfig := service.CertConfig{
+			Issuers:       []service.IssuerConfig{{Name: "own", Server: "https://acme.example.com/directory", Email: "foo@example.com"}},
+			DefaultIssuer: &service.DefaultIssuer{Name: "own"},
+		}
+		Expect(validation.ValidateCertConfig(&certConfig, shootCluster, extConfig)).To(BeEmpty())
+
+		certConfig.DefaultIssuer.Name = "garden"
+		Expect(validation.ValidateCertConfig(&certConfig, shootCluster, extConfig)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("defaultIssuer.name"),
+			})),
+		))
+	})
+	DescribeTable("#RestrictedDomains",
+		func(fromProviders bool, providers []gardencorev1beta1.DNSProvider, expected []string) {
+			serviceConfig := extConfig.DeepCopy()
+			serviceConfig.RestrictedDomainsFromDNSProviders = &fromProviders
+			shootCluster := &controller.Cluster{Shoot: cluster.Shoot.DeepCopy()}
+			shootCluster.Shoot.Spec.DNS.Providers = providers
+			Expect(validation.RestrictedDomains(serviceConfig, shootCluster)).To(Equal(expected))
+		},
+		Entry("Shoot domain only", false, []gardencorev1beta1.DNSProvider{
+			{Domains: &gardencorev1beta1.DNSIncludeExclude{Include: []string{"other.example.org"}}},
+		}, []string{"foo.example.com"}),
+		Entry("Shoot domain and included provider domains", true, []gardencorev1beta1.DNSProvider{
+			{Domains: &gardencorev1beta1.DNSIncludeExclude{Include: []string{"other.example.org", "sub.foo.example.com"}}},
+			{},
+			{Domains: &gardencorev1beta1.DNSIncludeExclude{Include: []string{"Third.Example.org", "excluded.example.org"}, Exclude: []string{"excluded.example.org"}}},
+		}, []string{"foo.example.com", "other.example.org", "third.example.org"}),
+		Entry("Included provider domain with excluded subdomains", true, []gardencorev1beta1.DNSProvider{
+			{Domains: &gardencorev1beta1.DNSIncludeExclude{Include: []string{"other.example.org", "example.net"}, Exclude: []string{"Internal.Example.net"}}},
+		}, []string{"foo.example.com", "other.example.org"}),
+	)
+	DescribeTable("#ValidateCertConfigDNSProviderDomains",
+		func(fromProviders bool, match gomegatypes.GomegaMatcher) {
+			serviceConfig := extConfig.DeepCopy()
+			serviceConfig.RestrictedDomainsFromDNSProviders = &fromProviders
+			shootCluster := &controller.Cluster{Shoot: cluster.Shoot.DeepCopy()}
+			shootCluster.Shoot.Spec.DNS.Providers = []gardencorev1beta1.DNSProvider{
+				{Domains: &gardencorev1beta1.DNSIncludeExclude{Include: []string{"other.example.org"}}},
+			}
+			certConfig := service.CertConfig{
+				Certificates:     []service.Certificate{{Name: "cert", SecretName: "cert", DNSNames: []string{"www.other.example.org"}}},
+				KubeAPIServerSNI: &service.KubeAPIServerSNI{Enabled: true, AdditionalDomains: []string{"api.other.example.org"}},
+			}
+			Expect(validation.ValidateCertConfig(&certConfig, shootCluster, serviceConfig)).To(match)
+		},
+		Entry("Provider domains allowed", true, BeEmpty()),
+		Entry("Provider domains not allowed", false, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("certificates[0].dnsNames[0]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("kubeAPIServerSNI.additionalDomains[0]"),
+			})),
+		)),
+	)
+	DescribeTable("#ValidateCertConfigRuntimeCluster",
+		func(config service.CertConfig, match gomegatypes.GomegaMatcher) {
+			err := validation.ValidateCertConfig(&config, nil, extConfig)
+			Expect(err).To(match)
+		},
+		Entry("No issuers", service.CertConfig{}, BeEmpty()),
+		Entry("Unsupported issuer", service.CertConfig{
+			Issuers: []service.IssuerConfig{
+				{
+					Name:   "issuer",
+					Server: "https://acme-v02.api.letsencrypt.org/directory",
+					Email:  "john@example.com",
+				},
+			},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("issuers"),
+			})),
+		)),
+		Entry("Unsupported DNSChallengeOnShoot", service.CertConfig{
+			DNSChallengeOnShoot: &service.DNSChallengeOnShoot{
+				Enabled:   true,
+				Namespace: "kube-system",
+			},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("dnsChallengeOnShoot"),
+			})),
+		)),
+		Entry("Unsupported PrecheckNameservers", service.CertConfig{
+			PrecheckNameservers: &nameservers,
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("precheckNameservers"),
+			})),
+		)),
+		Entry("Unsupported ShootIssuer", service.CertConfig{
+			ShootIssuers: &service.ShootIssuers{
+				Enabled: true,
+			},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("shootIssuers"),
+			})),
+		)),
+		Entry("Unsupported ComplianceProfile", service.CertConfig{
+			ComplianceProfile: new("regulated"),
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("complianceProfile"),
+			})),
+		)),
+		Entry("Unsupported DefaultIssuer", service.CertConfig{
+			DefaultIssuer: &service.DefaultIssuer{Name: "garden"},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("defaultIssuer"),
+			})),
+		)),
+		Entry("Unsupported Alerting", service.CertConfig{
+			Alerting: &service.Alerting{},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("alerting"),
+			})),
+		)),
+		Entry("Unsupported ClusterCAIssuer", service.CertConfig{
+			ClusterCAIssuer: &service.ClusterCAIssuer{Enabled: true},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("clusterCAIssuer"),
+			})),
+		)),
+		Entry("Unsupported TrustBundle", service.CertConfig{
+			TrustBundle: &service.TrustBundle{Enabled: true},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("trustBundle"),
+			})),
+		)),
+		Entry("Unsupported CSRSigner", service.CertConfig{
+			CSRSigner: &service.CSRSigner{Enabled: true},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("csrSigner"),
+			})),
+		)),
+		Entry("Unsupported certificates", service.CertConfig{
+			Certificates: []service.Certificate{{Name: "foo"}},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("certificates"),
+			})),
+		)),
+		Entry("Unsupported KubeAPIServerSNI", service.CertConfig{
+			KubeAPIServerSNI: &service.KubeAPIServerSNI{Enabled: true},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("kubeAPIServerSNI"),
+			})),
+		)),
+		Entry("Unsupported SecretReplication", service.CertConfig{
+			SecretReplication: &service.SecretReplication{Enabled: true},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("secretReplication"),
+			})),
+		)),
+		Entry("Unsupported IngressCertificate", service.CertConfig{
+			IngressCertificate: &service.IngressCertificate{Enabled: true},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("ingressCertificate"),
 			})),
 		)),
 	)
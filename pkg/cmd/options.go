@@ -7,6 +7,7 @@ package cmd
 import (
 	"errors"
 	"os"
+	"time"
 
 	extensionsapisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	"github.com/gardener/gardener/extensions/pkg/controller/cmd"
@@ -20,9 +21,12 @@ import (
 	config "github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config/v1alpha1"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config/validation"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/csrsigner"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/controlplane"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shoot"
 	healthcheckcontroller "github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/healthcheck"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/secretreplication"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/trustbundle"
 )
 
 var (
@@ -40,13 +44,16 @@ func init() {
 
 // CertificateServiceOptions holds options related to the certificate service.
 type CertificateServiceOptions struct {
-	ConfigLocation string
-	config         *CertificateServiceConfig
+	ConfigLocation      string
+	CSRSignerSyncPeriod time.Duration
+	config              *CertificateServiceConfig
 }
 
 // AddFlags implements Flagger.AddFlags.
 func (o *CertificateServiceOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.ConfigLocation, "config", "", "Path to cert service configuration")
+	fs.DurationVar(&o.CSRSignerSyncPeriod, "csr-signer-sync-period", csrsigner.DefaultAddOptions.SyncPeriod,
+		"Period for checking the certificate signing requests of shoot clusters with enabled CSR signer")
 }
 
 // Complete implements Completer.Complete.
@@ -69,8 +76,13 @@ func (o *CertificateServiceOptions) Complete() error {
 		return errs.ToAggregate()
 	}
 
+	if o.CSRSignerSyncPeriod <= 0 {
+		return errors.New("csr signer sync period must be positive")
+	}
+
 	o.config = &CertificateServiceConfig{
-		config: config,
+		config:              config,
+		csrSignerSyncPeriod: o.CSRSignerSyncPeriod,
 	}
 
 	return nil
@@ -83,7 +95,8 @@ func (o *CertificateServiceOptions) Completed() *CertificateServiceConfig {
 
 // CertificateServiceConfig contains configuration information about the certificate service.
 type CertificateServiceConfig struct {
-	config config.Configuration
+	config              config.Configuration
+	csrSignerSyncPeriod time.Duration
 }
 
 // Apply applies the CertificateServiceOptions to the passed ControllerOptions instance.
@@ -96,11 +109,19 @@ func ControllerSwitches() *cmd.SwitchOptions {
 	return cmd.NewSwitchOptions(
 		cmd.Switch(shoot.ControllerName, shoot.AddToManager),
 		cmd.Switch(controlplane.ControllerName, controlplane.AddToManager),
+		cmd.Switch(csrsigner.ControllerName, csrsigner.AddToManager),
+		cmd.Switch(secretreplication.ControllerName, secretreplication.AddToManager),
+		cmd.Switch(trustbundle.ControllerName, trustbundle.AddToManager),
 		cmd.Switch(extensionshealthcheckcontroller.ControllerName, healthcheckcontroller.AddToManager),
 		cmd.Switch(extensionsheartbeatcontroller.ControllerName, extensionsheartbeatcontroller.AddToManager),
 	)
 }
 
+// ApplyCSRSignerSyncPeriod applies the sync period of the CSR signer controller.
+func (c *CertificateServiceConfig) ApplyCSRSignerSyncPeriod(syncPeriod *time.Duration) {
+	*syncPeriod = c.csrSignerSyncPeriod
+}
+
 func (c *CertificateServiceConfig) ApplyHealthCheckConfig(config *extensionsapisconfigv1alpha1.HealthCheckConfig) {
 	if c.config.HealthCheckConfig != nil {
 		*config = *c.config.HealthCheckConfig
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csrsigner
+
+import (
+	"context"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shared"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shoot"
+)
+
+const (
+	// ControllerName is the name of the controller signing certificate signing requests in shoot clusters.
+	ControllerName = "csr-signer"
+)
+
+var (
+	defaultSyncPeriod = 30 * time.Second
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{SyncPeriod: defaultSyncPeriod}
+)
+
+// AddOptions are options to apply when adding the CSR signer controller to the manager.
+type AddOptions struct {
+	// ControllerOptions contains options for the controller.
+	ControllerOptions controller.Options
+	// ServiceConfig contains configuration for the shoot cert service.
+	ServiceConfig config.Configuration
+	// SyncPeriod is the period for checking the certificate signing requests of a shoot cluster.
+	SyncPeriod time.Duration
+}
+
+// AddToManager adds a controller with the default Options to the given Controller Manager.
+func AddToManager(ctx context.Context, mgr manager.Manager) error {
+	return AddToManagerWithOptions(ctx, mgr, DefaultAddOptions)
+}
+
+// AddToManagerWithOptions adds a controller with the given Options to the given manager.
+func AddToManagerWithOptions(_ context.Context, mgr manager.Manager, opts AddOptions) error {
+	r := &Reconciler{
+		Client:            mgr.GetClient(),
+		CertConfigDecoder: shared.NewCertConfigDecoder(mgr, opts.ServiceConfig),
+		ServiceConfig:     opts.ServiceConfig,
+		SyncPeriod:        opts.SyncPeriod,
+		Clock:             clock.RealClock{},
+		NewShootClient: func(ctx context.Context, namespace string) (client.Client, error) {
+			return shoot.NewShootAccessClient(ctx, mgr.GetClient(), namespace, client.Options{Scheme: kubernetesscheme.Scheme})
+		},
+	}
+
+	return builder.ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&extensionsv1alpha1.Extension{}, builder.WithPredicates(
+			predicateutils.HasType(shoot.Type),
+			predicateutils.HasClass(extensionsv1alpha1.ExtensionClassShoot),
+			predicate.GenerationChangedPredicate{},
+		)).
+		WithOptions(opts.ControllerOptions).
+		Complete(r)
+}
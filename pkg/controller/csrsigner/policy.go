@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csrsigner
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"slices"
+	"strings"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
+)
+
+const serviceAccountUsernamePrefix = "system:serviceaccount:"
+
+var allowedUsages = sets.New(
+	certificatesv1.UsageDigitalSignature,
+	certificatesv1.UsageKeyEncipherment,
+	certificatesv1.UsageServerAuth,
+	certificatesv1.UsageClientAuth,
+)
+
+// parseCertificateRequest parses the PEM encoded x509 certificate request of the certificate signing request.
+func parseCertificateRequest(csr *certificatesv1.CertificateSigningRequest) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode(csr.Spec.Request)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, fmt.Errorf("PEM block type must be CERTIFICATE REQUEST")
+	}
+	req, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate request: %w", err)
+	}
+	if err := req.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("invalid signature of certificate request: %w", err)
+	}
+	return req, nil
+}
+
+// checkPolicy checks if the certificate signing request complies with the policy of the signer.
+func checkPolicy(signer *service.CSRSigner, csr *certificatesv1.CertificateSigningRequest, req *x509.CertificateRequest) error {
+	namespace, ok := serviceAccountNamespace(csr.Spec.Username)
+	if !ok {
+		return fmt.Errorf("requester %q is not a service account", csr.Spec.Username)
+	}
+	if !slices.Contains(signer.AllowedNamespaces, namespace) {
+		return fmt.Errorf("namespace %q of requester is not allowed", namespace)
+	}
+
+	if len(req.IPAddresses)+len(req.EmailAddresses)+len(req.URIs) > 0 {
+		return fmt.Errorf("only DNS names are allowed as subject alternative names")
+	}
+	names := requestedDNSNames(req)
+	if len(names) == 0 {
+		return fmt.Errorf("no DNS names requested")
+	}
+	for _, name := range names {
+		if !slices.ContainsFunc(signer.AllowedDNSNames, func(pattern string) bool { return matchesDNSNamePattern(name, pattern) }) {
+			return fmt.Errorf("DNS name %q is not allowed", name)
+		}
+	}
+
+	for _, usage := range csr.Spec.Usages {
+		if !allowedUsages.Has(usage) {
+			return fmt.Errorf("usage %q is not allowed", usage)
+		}
+	}
+	return nil
+}
+
+// requestedDNSNames returns the DNS names and the common name of the certificate request without duplicates.
+func requestedDNSNames(req *x509.CertificateRequest) []string {
+	names := slices.Clone(req.DNSNames)
+	if req.Subject.CommonName != "" && !slices.Contains(names, req.Subject.CommonName) {
+		names = append(names, req.Subject.CommonName)
+	}
+	return names
+}
+
+func serviceAccountNamespace(username string) (string, bool) {
+	name, ok := strings.CutPrefix(username, serviceAccountUsernamePrefix)
+	if !ok {
+		return "", false
+	}
+	parts := strings.Split(name, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", false
+	}
+	return parts[0], true
+}
+
+func matchesDNSNamePattern(name, pattern string) bool {
+	name = strings.ToLower(name)
+	pattern = strings.ToLower(pattern)
+	if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+		// like a wildcard certificate, the wildcard matches exactly one label
+		label, ok := strings.CutSuffix(name, "."+suffix)
+		return ok && label != "" && !strings.Contains(label, ".")
+	}
+	return name == pattern
+}
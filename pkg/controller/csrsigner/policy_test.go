@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csrsigner
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	certificatesv1 "k8s.io/api/certificates/v1"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
+)
+
+func newCertificateRequestPEM(template *x509.CertificateRequest) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	Expect(err).NotTo(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+}
+
+func newCSR(name, username string, template *x509.CertificateRequest, usages ...certificatesv1.KeyUsage) *certificatesv1.CertificateSigningRequest {
+	csr := &certificatesv1.CertificateSigningRequest{
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:    newCertificateRequestPEM(template),
+			SignerName: "example.com/shoot",
+			Username:   username,
+			Usages:     usages,
+		},
+	}
+	csr.Name = name
+	return csr
+}
+
+var _ = Describe("Policy", func() {
+	signer := &service.CSRSigner{
+		Enabled:           true,
+		SignerName:        "example.com/shoot",
+		AllowedNamespaces: []string{"default"},
+		AllowedDNSNames:   []string{"*.default.svc.cluster.local", "my-service.example.com"},
+	}
+
+	DescribeTable("#checkPolicy",
+		func(username string, template *x509.CertificateRequest, usages []certificatesv1.KeyUsage, errMatcher gomegatypes.GomegaMatcher) {
+			csr := newCSR("test", username, template, usages...)
+			req, err := parseCertificateRequest(csr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checkPolicy(signer, csr, req)).To(errMatcher)
+		},
+		Entry("should accept allowed DNS names",
+			"system:serviceaccount:default:app",
+			&x509.CertificateRequest{Subject: pkix.Name{CommonName: "foo.default.svc.cluster.local"}, DNSNames: []string{"My-Service.example.com"}},
+			[]certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageServerAuth},
+			Succeed()),
+		Entry("should reject requester which is not a service account",
+			"alice",
+			&x509.CertificateRequest{DNSNames: []string{"my-service.example.com"}},
+			nil,
+			MatchError(ContainSubstring("is not a service account"))),
+		Entry("should reject service account of other namespace",
+			"system:serviceaccount:kube-system:app",
+			&x509.CertificateRequest{DNSNames: []string{"my-service.example.com"}},
+			nil,
+			MatchError(ContainSubstring("namespace \"kube-system\" of requester is not allowed"))),
+		Entry("should reject DNS name not matching",
+			"system:serviceaccount:default:app",
+			&x509.CertificateRequest{DNSNames: []string{"svc.cluster.local"}},
+			nil,
+			MatchError(ContainSubstring("DNS name \"svc.cluster.local\" is not allowed"))),
+		Entry("should reject DNS name with more than one label matching the wildcard",
+			"system:serviceaccount:default:app",
+			&x509.CertificateRequest{DNSNames: []string{"foo.bar.default.svc.cluster.local"}},
+			nil,
+			MatchError(ContainSubstring("DNS name \"foo.bar.default.svc.cluster.local\" is not allowed"))),
+		Entry("should reject DNS name matching the wildcard suffix only",
+			"system:serviceaccount:default:app",
+			&x509.CertificateRequest{DNSNames: []string{"default.svc.cluster.local"}},
+			nil,
+			MatchError(ContainSubstring("DNS name \"default.svc.cluster.local\" is not allowed"))),
+		Entry("should reject common name not matching",
+			"system:serviceaccount:default:app",
+			&x509.CertificateRequest{Subject: pkix.Name{CommonName: "other.example.com"}, DNSNames: []string{"my-service.example.com"}},
+			nil,
+			MatchError(ContainSubstring("DNS name \"other.example.com\" is not allowed"))),
+		Entry("should reject IP addresses",
+			"system:serviceaccount:default:app",
+			&x509.CertificateRequest{DNSNames: []string{"my-service.example.com"}, IPAddresses: []net.IP{net.ParseIP("10.0.0.1")}},
+			nil,
+			MatchError(ContainSubstring("only DNS names are allowed"))),
+		Entry("should reject request without names",
+			"system:serviceaccount:default:app",
+			&x509.CertificateRequest{},
+			nil,
+			MatchError(ContainSubstring("no DNS names requested"))),
+		Entry("should reject disallowed usages",
+			"system:serviceaccount:default:app",
+			&x509.CertificateRequest{DNSNames: []string{"my-service.example.com"}},
+			[]certificatesv1.KeyUsage{certificatesv1.UsageCertSign},
+			MatchError(ContainSubstring("usage \"cert sign\" is not allowed"))),
+	)
+
+	It("should reject malformed requests", func() {
+		csr := &certificatesv1.CertificateSigningRequest{Spec: certificatesv1.CertificateSigningRequestSpec{Request: []byte("foo")}}
+		_, err := parseCertificateRequest(csr)
+		Expect(err).To(MatchError(ContainSubstring("CERTIFICATE REQUEST")))
+	})
+})
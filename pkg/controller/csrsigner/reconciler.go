@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csrsigner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
+	"github.com/gardener/cert-management/pkg/shared/legobridge"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shared"
+)
+
+const (
+	reasonAutoApproved    = "AutoApproved"
+	reasonPolicyViolation = "PolicyViolation"
+	reasonSigningFailed   = "SigningFailed"
+)
+
+// Reconciler approves and signs certificate signing requests in the shoot cluster for the signer name configured
+// in the shoot cert service extension. The certificates are signed with the CA of the configured CA issuer.
+type Reconciler struct {
+	// Client is the client for the seed cluster.
+	Client            client.Client
+	CertConfigDecoder shared.CertConfigDecoder
+	ServiceConfig     config.Configuration
+	SyncPeriod        time.Duration
+	Clock             clock.Clock
+	// NewShootClient creates a client for the shoot cluster of the given shoot namespace in the seed,
+	// which has the permissions of the shoot access secret of the extension.
+	NewShootClient func(ctx context.Context, namespace string) (client.Client, error)
+
+	shootClients shootClientCache
+}
+
+// Reconcile processes the pending certificate signing requests of the shoot cluster belonging to the extension.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ex := &extensionsv1alpha1.Extension{}
+	if err := r.Client.Get(ctx, request.NamespacedName, ex); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.forgetShootClient(request.Namespace)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving extension: %w", err)
+	}
+	if ex.DeletionTimestamp != nil {
+		r.forgetShootClient(ex.Namespace)
+		return reconcile.Result{}, nil
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, r.Client, ex.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if extensionscontroller.IsHibernated(cluster) || cluster.Shoot.DeletionTimestamp != nil {
+		r.forgetShootClient(ex.Namespace)
+		return reconcile.Result{}, nil
+	}
+
+	certConfig, err := r.CertConfigDecoder.DecodeAndValidateProviderConfig(ex, cluster)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if certConfig.CSRSigner == nil || !certConfig.CSRSigner.Enabled {
+		r.forgetShootClient(ex.Namespace)
+		return reconcile.Result{}, nil
+	}
+
+	signer, err := r.loadSigner(ctx, ex.Namespace, certConfig)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	shootClient, err := r.shootClient(ctx, ex.Namespace)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to create shoot client: %w", err)
+	}
+
+	csrList := &certificatesv1.CertificateSigningRequestList{}
+	if err := shootClient.List(ctx, csrList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to list certificate signing requests: %w", err)
+	}
+	// a failing request must not block the other requests of the shoot cluster
+	var errs []error
+	for i := range csrList.Items {
+		csr := &csrList.Items[i]
+		if csr.Spec.SignerName != certConfig.CSRSigner.SignerName {
+			continue
+		}
+		if err := r.process(ctx, shootClient, certConfig.CSRSigner, signer, csr); err != nil {
+			errs = append(errs, fmt.Errorf("failed to process certificate signing request %s: %w", csr.Name, err))
+			continue
+		}
+		log.V(1).Info("Processed certificate signing request", "csr", csr.Name)
+	}
+
+	if len(errs) > 0 {
+		// the failed requests are retried with backoff
+		return reconcile.Result{}, errors.Join(errs...)
+	}
+	return reconcile.Result{RequeueAfter: r.SyncPeriod}, nil
+}
+
+// loadSigner reads the CA key pair of the issuer used for signing from the shoot namespace in the seed.
+func (r *Reconciler) loadSigner(ctx context.Context, namespace string, certConfig *service.CertConfig) (*caSigner, error) {
+	issuerName := ptr.Deref(certConfig.CSRSigner.IssuerName, shared.DefaultIssuerName(r.ServiceConfig, *certConfig))
+	issuer := &certv1alpha1.Issuer{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: issuerName}, issuer); err != nil {
+		return nil, fmt.Errorf("failed to get issuer %s: %w", issuerName, err)
+	}
+	if issuer.Spec.CA == nil || issuer.Spec.CA.PrivateKeySecretRef == nil {
+		return nil, fmt.Errorf("issuer %s is not a CA issuer", issuerName)
+	}
+
+	ref := issuer.Spec.CA.PrivateKeySecretRef
+	secretNamespace := ref.Namespace
+	if secretNamespace == "" {
+		secretNamespace = namespace
+	}
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: secretNamespace, Name: ref.Name}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get CA secret of issuer %s: %w", issuerName, err)
+	}
+	keyPair, err := legobridge.CAKeyPairFromSecretData(secret.Data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CA secret of issuer %s: %w", issuerName, err)
+	}
+	return &caSigner{keyPair: keyPair}, nil
+}
+
+// process approves or denies a pending certificate signing request and signs it if it is approved.
+func (r *Reconciler) process(ctx context.Context, shootClient client.Client, csrSigner *service.CSRSigner, signer *caSigner, csr *certificatesv1.CertificateSigningRequest) error {
+	if len(csr.Status.Certificate) > 0 || hasCondition(csr, certificatesv1.CertificateDenied) || hasCondition(csr, certificatesv1.CertificateFailed) {
+		return nil
+	}
+
+	req, err := parseCertificateRequest(csr)
+	if err == nil {
+		err = checkPolicy(csrSigner, csr, req)
+	}
+
+	if !hasCondition(csr, certificatesv1.CertificateApproved) {
+		if err != nil {
+			return r.setCondition(ctx, shootClient.SubResource("approval"), csr, certificatesv1.CertificateDenied, reasonPolicyViolation, err.Error())
+		}
+		if err := r.setCondition(ctx, shootClient.SubResource("approval"), csr, certificatesv1.CertificateApproved, reasonAutoApproved,
+			"Auto-approved by shoot cert service"); err != nil {
+			return err
+		}
+	} else if err != nil {
+		// approved manually, but the signer refuses to issue certificates violating its policy
+		return r.setCondition(ctx, shootClient.Status(), csr, certificatesv1.CertificateFailed, reasonPolicyViolation, err.Error())
+	}
+
+	certificate, err := signer.sign(csr, req, r.Clock.Now())
+	if err != nil {
+		return r.setCondition(ctx, shootClient.Status(), csr, certificatesv1.CertificateFailed, reasonSigningFailed, err.Error())
+	}
+	csr.Status.Certificate = certificate
+	return shootClient.Status().Update(ctx, csr)
+}
+
+func (r *Reconciler) setCondition(ctx context.Context, writer client.SubResourceWriter, csr *certificatesv1.CertificateSigningRequest,
+	conditionType certificatesv1.RequestConditionType, reason, message string) error {
+	now := metav1.NewTime(r.Clock.Now())
+	csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+		Type:               conditionType,
+		Status:             corev1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		LastUpdateTime:     now,
+		LastTransitionTime: now,
+	})
+	return writer.Update(ctx, csr)
+}
+
+func hasCondition(csr *certificatesv1.CertificateSigningRequest, conditionType certificatesv1.RequestConditionType) bool {
+	for _, condition := range csr.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
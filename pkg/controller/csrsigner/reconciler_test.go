@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csrsigner
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"time"
+
+	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/install"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shared"
+)
+
+var _ = Describe("Reconciler", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx         = context.Background()
+		now         = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		seedClient  client.Client
+		shootClient client.Client
+		r           *Reconciler
+		ex          *extensionsv1alpha1.Extension
+
+		providerConfig = `{"apiVersion":"service.cert.extensions.gardener.cloud/v1alpha1","kind":"CertConfig",
+"clusterCAIssuer":{"enabled":true},
+"csrSigner":{"enabled":true,"signerName":"example.com/shoot","issuerName":"cluster-ca",
+"allowedNamespaces":["default"],"allowedDNSNames":["*.default.svc.cluster.local"]}}`
+
+		getCSR = func(name string) *certificatesv1.CertificateSigningRequest {
+			csr := &certificatesv1.CertificateSigningRequest{}
+			Expect(shootClient.Get(ctx, client.ObjectKey{Name: name}, csr)).To(Succeed())
+			return csr
+		}
+		reconcileExtension = func() {
+			result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(ex)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Minute))
+		}
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(kubernetesscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(certv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(install.AddToScheme(scheme)).To(Succeed())
+
+		ca, err := (&secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert}).Generate()
+		Expect(err).NotTo(HaveOccurred())
+		caCert := ca.(*secretsutils.Certificate)
+
+		shootRaw, err := json.Marshal(&gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{DNS: &gardencorev1beta1.DNS{Domain: new("foo.example.com")}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		ex = &extensionsv1alpha1.Extension{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot-cert-service", Namespace: namespace},
+			Spec: extensionsv1alpha1.ExtensionSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:           "shoot-cert-service",
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(providerConfig)},
+				},
+			},
+		}
+		seedClient = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(
+			ex,
+			&extensionsv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: namespace},
+				Spec: extensionsv1alpha1.ClusterSpec{
+					CloudProfile: runtime.RawExtension{Raw: []byte("{}")},
+					Seed:         &runtime.RawExtension{Raw: []byte("{}")},
+					Shoot:        runtime.RawExtension{Raw: shootRaw},
+				},
+			},
+			&certv1alpha1.Issuer{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster-ca", Namespace: namespace},
+				Spec: certv1alpha1.IssuerSpec{
+					CA: &certv1alpha1.CASpec{PrivateKeySecretRef: &corev1.SecretReference{Name: "issuer-ca", Namespace: namespace}},
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "issuer-ca", Namespace: namespace},
+				Data: map[string][]byte{
+					corev1.TLSCertKey:       caCert.CertificatePEM,
+					corev1.TLSPrivateKeyKey: caCert.PrivateKeyPEM,
+				},
+			},
+		).Build()
+
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).
+			WithStatusSubresource(&certificatesv1.CertificateSigningRequest{}).Build()
+
+		r = &Reconciler{
+			Client:            seedClient,
+			CertConfigDecoder: shared.NewCertConfigDecoderForScheme(scheme, config.Configuration{IssuerName: "garden"}),
+			ServiceConfig:     config.Configuration{IssuerName: "garden"},
+			SyncPeriod:        time.Minute,
+			Clock:             testclock.NewFakeClock(now),
+			NewShootClient: func(_ context.Context, _ string) (client.Client, error) {
+				return shootClient, nil
+			},
+		}
+	})
+
+	It("should approve and sign a valid request", func() {
+		Expect(shootClient.Create(ctx, newCSR("valid", "system:serviceaccount:default:app",
+			&x509.CertificateRequest{
+				Subject:  pkix.Name{CommonName: "app.default.svc.cluster.local", Organization: []string{"system:masters"}},
+				DNSNames: []string{"app2.default.svc.cluster.local"},
+			},
+			certificatesv1.UsageDigitalSignature, certificatesv1.UsageServerAuth))).To(Succeed())
+
+		reconcileExtension()
+
+		csr := getCSR("valid")
+		Expect(csr.Status.Conditions).To(ConsistOf(HaveField("Type", certificatesv1.CertificateApproved)))
+		block, _ := pem.Decode(csr.Status.Certificate)
+		Expect(block).NotTo(BeNil())
+		cert, err := x509.ParseCertificate(block.Bytes)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert.Subject.CommonName).To(Equal("app.default.svc.cluster.local"))
+		Expect(cert.Subject.Organization).To(BeEmpty())
+		Expect(cert.DNSNames).To(ConsistOf("app2.default.svc.cluster.local", "app.default.svc.cluster.local"))
+		Expect(cert.ExtKeyUsage).To(ConsistOf(x509.ExtKeyUsageServerAuth))
+		Expect(cert.NotAfter).To(Equal(now.Add(maxCertificateDuration)))
+	})
+
+	It("should deny a request violating the policy", func() {
+		Expect(shootClient.Create(ctx, newCSR("invalid", "system:serviceaccount:default:app",
+			&x509.CertificateRequest{DNSNames: []string{"app.example.com"}}))).To(Succeed())
+
+		reconcileExtension()
+
+		csr := getCSR("invalid")
+		Expect(csr.Status.Conditions).To(ConsistOf(And(
+			HaveField("Type", certificatesv1.CertificateDenied),
+			HaveField("Reason", reasonPolicyViolation),
+		)))
+		Expect(csr.Status.Certificate).To(BeEmpty())
+	})
+
+	It("should process the other requests if a request fails", func() {
+		failingClient := interceptor.NewClient(shootClient.(client.WithWatch), interceptor.Funcs{
+			SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				if obj.GetName() == "failing" {
+					return errors.New("conflict")
+				}
+				return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+			},
+		})
+		r.NewShootClient = func(_ context.Context, _ string) (client.Client, error) {
+			return failingClient, nil
+		}
+		Expect(shootClient.Create(ctx, newCSR("failing", "system:serviceaccount:default:app",
+			&x509.CertificateRequest{Subject: pkix.Name{CommonName: "failing.default.svc.cluster.local"}}))).To(Succeed())
+		Expect(shootClient.Create(ctx, newCSR("valid", "system:serviceaccount:default:app",
+			&x509.CertificateRequest{Subject: pkix.Name{CommonName: "app.default.svc.cluster.local"}},
+			certificatesv1.UsageDigitalSignature, certificatesv1.UsageServerAuth))).To(Succeed())
+
+		result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(ex)})
+		Expect(err).To(MatchError(ContainSubstring("failed to process certificate signing request failing: conflict")))
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(getCSR("valid").Status.Certificate).NotTo(BeEmpty())
+	})
+
+	It("should ignore requests for other signers", func() {
+		csr := newCSR("other", "system:serviceaccount:default:app",
+			&x509.CertificateRequest{Subject: pkix.Name{CommonName: "app.default.svc.cluster.local"}})
+		csr.Spec.SignerName = "example.com/other"
+		Expect(shootClient.Create(ctx, csr)).To(Succeed())
+
+		reconcileExtension()
+
+		Expect(getCSR("other").Status.Conditions).To(BeEmpty())
+	})
+
+	It("should reuse the shoot client until the kubeconfig changes", func() {
+		created := 0
+		r.NewShootClient = func(_ context.Context, _ string) (client.Client, error) {
+			created++
+			return shootClient, nil
+		}
+
+		reconcileExtension()
+		reconcileExtension()
+		Expect(created).To(Equal(1))
+
+		Expect(seedClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "gardener-internal", Namespace: namespace}})).To(Succeed())
+		reconcileExtension()
+		Expect(created).To(Equal(2))
+	})
+
+	It("should fail if the default issuer is not a CA issuer", func() {
+		ex.Spec.ProviderConfig.Raw = []byte(`{"apiVersion":"service.cert.extensions.gardener.cloud/v1alpha1","kind":"CertConfig",
+"csrSigner":{"enabled":true,"signerName":"example.com/shoot","allowedNamespaces":["default"],"allowedDNSNames":["*.default.svc.cluster.local"]}}`)
+		Expect(seedClient.Update(ctx, ex)).To(Succeed())
+
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(ex)})
+		Expect(err).To(MatchError(ContainSubstring("csrSigner.issuerName")))
+	})
+
+	It("should fail if the issuer is not a CA issuer", func() {
+		issuer := &certv1alpha1.Issuer{}
+		Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "cluster-ca"}, issuer)).To(Succeed())
+		issuer.Spec.CA = nil
+		issuer.Spec.ACME = &certv1alpha1.ACMESpec{Server: "https://acme.example.com", Email: "foo@example.com"}
+		Expect(seedClient.Update(ctx, issuer)).To(Succeed())
+
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(ex)})
+		Expect(err).To(MatchError(ContainSubstring("issuer cluster-ca is not a CA issuer")))
+	})
+})
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csrsigner
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// shootClientCache caches the clients for the shoot clusters, as the certificate signing requests are polled with a
+// short sync period. A client is replaced if a kubeconfig secret of the shoot cluster has changed, e.g. on a CA rotation.
+type shootClientCache struct {
+	lock    sync.Mutex
+	clients map[string]cachedShootClient
+}
+
+type cachedShootClient struct {
+	version string
+	client  client.Client
+}
+
+// shootClient returns the cached client for the shoot cluster of the given shoot namespace in the seed or creates a
+// new one if the kubeconfig secrets have changed.
+func (r *Reconciler) shootClient(ctx context.Context, namespace string) (client.Client, error) {
+	version, err := r.kubeconfigVersion(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	r.shootClients.lock.Lock()
+	defer r.shootClients.lock.Unlock()
+
+	if cached, ok := r.shootClients.clients[namespace]; ok && cached.version == version {
+		return cached.client, nil
+	}
+	shootClient, err := r.NewShootClient(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if r.shootClients.clients == nil {
+		r.shootClients.clients = map[string]cachedShootClient{}
+	}
+	r.shootClients.clients[namespace] = cachedShootClient{version: version, client: shootClient}
+	return shootClient, nil
+}
+
+// forgetShootClient removes the cached client for the shoot cluster of the given shoot namespace.
+func (r *Reconciler) forgetShootClient(namespace string) {
+	r.shootClients.lock.Lock()
+	defer r.shootClients.lock.Unlock()
+
+	delete(r.shootClients.clients, namespace)
+}
+
+// kubeconfigVersion returns the combined resource versions of the secrets the shoot client is created from.
+func (r *Reconciler) kubeconfigVersion(ctx context.Context, namespace string) (string, error) {
+	version := ""
+	for _, name := range []string{v1beta1constants.SecretNameGardenerInternal, v1beta1constants.SecretNameGardener} {
+		secret := &corev1.Secret{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret); client.IgnoreNotFound(err) != nil {
+			return "", fmt.Errorf("failed to get kubeconfig of shoot cluster: %w", err)
+		}
+		version += "/" + secret.ResourceVersion
+	}
+	return version, nil
+}
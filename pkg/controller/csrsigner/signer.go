@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package csrsigner
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/gardener/cert-management/pkg/shared/legobridge"
+	certificatesv1 "k8s.io/api/certificates/v1"
+)
+
+const (
+	// maxCertificateDuration is the maximum duration of a signed certificate.
+	// It is used if the certificate signing request does not specify a shorter expiration.
+	maxCertificateDuration = 90 * 24 * time.Hour
+	// backdate is subtracted from the current time for the start of the validity to tolerate clock skew.
+	backdate = 5 * time.Minute
+)
+
+// caSigner signs certificate signing requests with the CA of a CA issuer.
+type caSigner struct {
+	keyPair *legobridge.TLSKeyPair
+}
+
+// sign creates a PEM encoded certificate for the given certificate signing request.
+func (s *caSigner) sign(csr *certificatesv1.CertificateSigningRequest, req *x509.CertificateRequest, now time.Time) ([]byte, error) {
+	duration := maxCertificateDuration
+	if csr.Spec.ExpirationSeconds != nil {
+		if requested := time.Duration(*csr.Spec.ExpirationSeconds) * time.Second; requested < duration {
+			duration = requested
+		}
+	}
+	notAfter := now.Add(duration)
+	if notAfter.After(s.keyPair.Cert.NotAfter) {
+		notAfter = s.keyPair.Cert.NotAfter
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+
+	// only the DNS names approved by the policy are taken over, other attributes of the requested subject are dropped
+	names := requestedDNSNames(req)
+	commonName := req.Subject.CommonName
+	if commonName == "" && len(names) > 0 {
+		commonName = names[0]
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: commonName},
+		DNSNames:              names,
+		NotBefore:             now.Add(-backdate),
+		NotAfter:              notAfter,
+		BasicConstraintsValid: true,
+	}
+	for _, usage := range csr.Spec.Usages {
+		switch usage {
+		case certificatesv1.UsageDigitalSignature:
+			template.KeyUsage |= x509.KeyUsageDigitalSignature
+		case certificatesv1.UsageKeyEncipherment:
+			template.KeyUsage |= x509.KeyUsageKeyEncipherment
+		case certificatesv1.UsageServerAuth:
+			template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageServerAuth)
+		case certificatesv1.UsageClientAuth:
+			template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageClientAuth)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, &s.keyPair.Cert, req.PublicKey, s.keyPair.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certificate: %w", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
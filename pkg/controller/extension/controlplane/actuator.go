@@ -65,7 +65,7 @@ func NewActuator(mgr manager.Manager, config config.Configuration, extensionClas
 		scheme:            mgr.GetScheme(),
 		serviceConfig:     config,
 		extensionClasses:  extensionClasses,
-		certConfigDecoder: shared.NewCertConfigDecoder(mgr),
+		certConfigDecoder: shared.NewCertConfigDecoder(mgr, config),
 	}
 }
 
@@ -92,7 +92,7 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, ex *extension
 		return err
 	}
 
-	values, err := a.createValues(certConfig, namespace, ex)
+	values, err := a.createValues(ctx, certConfig, namespace, ex)
 	if err != nil {
 		return err
 	}
@@ -178,6 +178,7 @@ func (a *actuator) Migrate(ctx context.Context, log logr.Logger, ex *extensionsv
 }
 
 func (a *actuator) createValues(
+	ctx context.Context,
 	certConfig *service.CertConfig,
 	namespace string,
 	ex *extensionsv1alpha1.Extension,
@@ -197,6 +198,13 @@ func (a *actuator) createValues(
 	}
 
 	var err error
+	if values.ExtensionConfig.Proxy != nil {
+		values.SeedKubeAPIServerHost, err = shared.SeedKubeAPIServerHost(ctx, a.client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	values.Image, err = shared.PrepareCertManagementImage()
 	if err != nil {
 		return nil, err
@@ -291,12 +299,13 @@ func isGardenDeployment(ex *extensionsv1alpha1.Extension) bool {
 }
 
 func setValuesForGardenOrSeed(ex *extensionsv1alpha1.Extension, values *shared.Values) error {
+	values.ExtensionNamespace = os.Getenv(shared.EnvLeaderElectionNamespace)
 	if isGardenDeployment(ex) {
 		values.CertClass = "garden"
 	} else {
 		values.CertClass = "seed"
 		// use the extension namespace for deployment of cert-manager-controller
-		values.Namespace = os.Getenv(shared.EnvLeaderElectionNamespace)
+		values.Namespace = values.ExtensionNamespace
 	}
 	return nil
 }
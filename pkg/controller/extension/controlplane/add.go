@@ -8,6 +8,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"os"
 	"slices"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
@@ -79,11 +80,12 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 			return c.Watch(source.Kind(
 				mgr.GetCache(),
 				&operatorv1alpha1.Garden{},
-				handler.TypedEnqueueRequestsFromMapFunc(mapGardenToExtension(mgr, mgr.GetLogger().WithName("mapGardenToExtension"))),
+				handler.TypedEnqueueRequestsFromMapFunc(mapGardenToExtension(mgr, opts.ServiceConfig, mgr.GetLogger().WithName("mapGardenToExtension"))),
 				&toTypedPredicate{predicate: operatorpredicate.GardenCreatedOrReconciledSuccessfully()},
 			))
 		})
 	}
+	watchBuilder.Register(shared.WatchReferencedSecrets(mgr, opts.ServiceConfig, os.Getenv(shared.EnvLeaderElectionNamespace), Type))
 
 	return extension.Add(mgr, extension.AddArgs{
 		Actuator:          NewActuator(mgr, opts.ServiceConfig, extensionClasses),
@@ -118,9 +120,9 @@ func (p *toTypedPredicate) Generic(e event.TypedGenericEvent[*operatorv1alpha1.G
 	return p.predicate.Generic(event.GenericEvent{Object: e.Object})
 }
 
-func mapGardenToExtension(mgr manager.Manager, log logr.Logger) func(context.Context, *operatorv1alpha1.Garden) []reconcile.Request {
+func mapGardenToExtension(mgr manager.Manager, serviceConfig config.Configuration, log logr.Logger) func(context.Context, *operatorv1alpha1.Garden) []reconcile.Request {
 	c := mgr.GetClient()
-	decoder := shared.NewCertConfigDecoder(mgr)
+	decoder := shared.NewCertConfigDecoder(mgr, serviceConfig)
 	return func(ctx context.Context, garden *operatorv1alpha1.Garden) []reconcile.Request {
 		extList := &extensionsv1alpha1.ExtensionList{}
 		if err := c.List(ctx, extList, client.InNamespace(constants.GardenNamespace)); err != nil {
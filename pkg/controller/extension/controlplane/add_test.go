@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
 	serviceinstall "github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/install"
 )
 
@@ -57,7 +58,7 @@ var _ = Describe("#mapGardenToExtension", func() {
 			Scheme: c.Scheme(),
 			Client: c,
 		}
-		mapper = mapGardenToExtension(mgr, logr.Discard())
+		mapper = mapGardenToExtension(mgr, config.Configuration{}, logr.Discard())
 		ex = &extensionsv1alpha1.Extension{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "garden-shoot-cert-service",
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ACMEHTTPClient creates the HTTP client for requests of the extension to the ACME server of the given issuer.
+// Like the cert-controller-manager, it uses the proxy of the extension configuration and trusts the custom root
+// certificates of the ACME configuration and of the issuer in addition to the system certificates.
+func (d *Deployer) ACMEHTTPClient(ctx context.Context, c client.Client, issuer Issuer) (*http.Client, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if caCertificates := d.values.caCertificates(); caCertificates != "" && !pool.AppendCertsFromPEM([]byte(caCertificates)) {
+		return nil, fmt.Errorf("failed to add CA certificates of the ACME configuration")
+	}
+	if issuer.ACME != nil && issuer.ACME.CACertificates != nil {
+		caCertificates, err := d.issuerCACertificates(ctx, c, issuer.ACME.CACertificates)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificates of issuer %s: %w", issuer.Name, err)
+		}
+		if !pool.AppendCertsFromPEM([]byte(caCertificates)) {
+			return nil, fmt.Errorf("failed to add CA certificates of issuer %s", issuer.Name)
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = d.values.proxyFunc()
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    pool,
+	}
+	return &http.Client{Timeout: 2 * time.Minute, Transport: transport}, nil
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+)
+
+var _ = Describe("ACMEHTTPClient", func() {
+	var (
+		ctx    = context.Background()
+		values Values
+	)
+
+	BeforeEach(func() {
+		values = Values{
+			ExtensionConfig: config.Configuration{
+				ACME: &config.ACME{Email: "foo@example.com", Server: "https://acme.example.com/directory"},
+				Proxy: &config.Proxy{
+					HTTPSProxy: new("http://proxy.example.com:3128"),
+					NoProxy:    new("acme.internal.example.com"),
+				},
+			},
+			Namespace:             "shoot--foo--bar",
+			SeedKubeAPIServerHost: "100.64.0.1",
+		}
+	})
+
+	It("should use the proxy of the extension configuration", func() {
+		httpClient, err := NewDeployer(values).ACMEHTTPClient(ctx, fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build(), Issuer{Name: "garden"})
+		Expect(err).NotTo(HaveOccurred())
+		transport := httpClient.Transport.(*http.Transport)
+
+		proxyURL, err := transport.Proxy(&http.Request{URL: &url.URL{Scheme: "https", Host: "acme.example.com"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proxyURL).To(Equal(&url.URL{Scheme: "http", Host: "proxy.example.com:3128"}))
+		proxyURL, err = transport.Proxy(&http.Request{URL: &url.URL{Scheme: "https", Host: "acme.internal.example.com"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proxyURL).To(BeNil())
+	})
+
+	It("should fail if the CA certificates of the issuer are missing", func() {
+		values.ExtensionConfig.Proxy = nil
+		issuer := Issuer{Name: "custom", ACME: &ACME{Server: "https://acme.custom.example.com/directory", CACertificates: &ReferencedResource{Kind: "ConfigMap", Name: "ref-custom-ca"}}}
+		_, err := NewDeployer(values).ACMEHTTPClient(ctx, fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build(), issuer)
+		Expect(err).To(MatchError(ContainSubstring("failed to read CA certificates of issuer custom")))
+	})
+})
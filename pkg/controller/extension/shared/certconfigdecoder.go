@@ -13,30 +13,43 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/validation"
 )
 
 // CertConfigDecoder is responsible for decoding and validating the cert config.
 type CertConfigDecoder struct {
-	decoder runtime.Decoder
+	decoder       runtime.Decoder
+	serviceConfig config.Configuration
 }
 
 // NewCertConfigDecoder creates a new instance of CertConfigDecoder.
-func NewCertConfigDecoder(mgr manager.Manager) CertConfigDecoder {
+func NewCertConfigDecoder(mgr manager.Manager, serviceConfig config.Configuration) CertConfigDecoder {
+	return NewCertConfigDecoderForScheme(mgr.GetScheme(), serviceConfig)
+}
+
+// NewCertConfigDecoderForScheme creates a new instance of CertConfigDecoder for the given scheme.
+func NewCertConfigDecoderForScheme(scheme *runtime.Scheme, serviceConfig config.Configuration) CertConfigDecoder {
 	return CertConfigDecoder{
-		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		decoder:       serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDecoder(),
+		serviceConfig: serviceConfig,
 	}
 }
 
 // DecodeAndValidateProviderConfig decodes the provider config from the given Extension and validates it.
+// If a cluster is given, the provider config is validated against the configuration effective for the seed of the cluster.
 func (d *CertConfigDecoder) DecodeAndValidateProviderConfig(ex *extensionsv1alpha1.Extension, cluster *controller.Cluster) (*service.CertConfig, error) {
 	certConfig := &service.CertConfig{}
 	if ex.Spec.ProviderConfig != nil {
 		if _, _, err := d.decoder.Decode(ex.Spec.ProviderConfig.Raw, nil, certConfig); err != nil {
 			return nil, fmt.Errorf("failed to decode provider config: %+v", err)
 		}
-		if errs := validation.ValidateCertConfig(certConfig, cluster); len(errs) > 0 {
+		serviceConfig := d.serviceConfig
+		if cluster != nil {
+			serviceConfig, _ = EffectiveConfiguration(d.serviceConfig, cluster.Seed)
+		}
+		if errs := validation.ValidateCertConfig(certConfig, cluster, &serviceConfig); len(errs) > 0 {
 			return nil, errs.ToAggregate()
 		}
 	}
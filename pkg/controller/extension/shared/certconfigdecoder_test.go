@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/install"
+)
+
+var _ = Describe("CertConfigDecoder", func() {
+	var (
+		decoder CertConfigDecoder
+		ex      *extensionsv1alpha1.Extension
+		cluster *controller.Cluster
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(install.AddToScheme(scheme)).To(Succeed())
+		decoder = NewCertConfigDecoderForScheme(scheme, config.Configuration{
+			IssuerName: "garden",
+			ACME: &config.ACME{
+				Email:               "foo@example.com",
+				Server:              "https://acme-v02.api.letsencrypt.org/directory",
+				PrecheckNameservers: new("8.8.8.8"),
+			},
+			CustomIssuerPolicy: &config.CustomIssuerPolicy{RestrictPrecheckNameservers: true},
+			Overrides: []config.ConfigurationOverride{
+				{
+					Name:    "eu",
+					Regions: []string{"eu-west-1"},
+					ACME:    &config.ACME{PrecheckNameservers: new("10.0.0.53")},
+				},
+			},
+		})
+
+		ex = &extensionsv1alpha1.Extension{
+			Spec: extensionsv1alpha1.ExtensionSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"service.cert.extensions.gardener.cloud/v1alpha1","kind":"CertConfig","precheckNameservers":"10.0.0.53"}`)},
+				},
+			},
+		}
+		cluster = &controller.Cluster{
+			Seed: &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: "aws-eu1"},
+				Spec:       gardencorev1beta1.SeedSpec{Provider: gardencorev1beta1.SeedProvider{Region: "eu-west-1"}},
+			},
+			Shoot: &gardencorev1beta1.Shoot{},
+		}
+	})
+
+	It("should validate the provider config against the configuration effective for the seed", func() {
+		certConfig, err := decoder.DecodeAndValidateProviderConfig(ex, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(certConfig.PrecheckNameservers).To(Equal(new("10.0.0.53")))
+	})
+
+	It("should reject the provider config if no override selects the seed", func() {
+		cluster.Seed.Spec.Provider.Region = "us-east-1"
+		_, err := decoder.DecodeAndValidateProviderConfig(ex, cluster)
+		Expect(err).To(MatchError(ContainSubstring(`nameserver "10.0.0.53" is not approved by the custom issuer policy`)))
+	})
+})
@@ -7,6 +7,21 @@ package shared
 const (
 	// EnvLeaderElectionNamespace is the environment variable name set in the deployment for providing the pod namespace.
 	EnvLeaderElectionNamespace = "LEADER_ELECTION_NAMESPACE"
+	// LabelCatalogIssuer is the label on issuers created from the issuer catalog. Its value is the name of the catalog issuer.
+	LabelCatalogIssuer = "shoot-cert-service.extensions.gardener.cloud/catalog-issuer"
+	// LabelFallbackIssuer is the label on fallback issuers of the default issuer.
+	LabelFallbackIssuer = "shoot-cert-service.extensions.gardener.cloud/fallback-issuer"
+	// LabelIssuerSecretCopy is the label on issuer secrets copied from a secret referenced in the extension configuration.
+	LabelIssuerSecretCopy = "shoot-cert-service.extensions.gardener.cloud/issuer-secret-copy"
+	// AnnotationCopiedFrom is the annotation on copied issuer secrets containing the namespace and name of the source secret.
+	AnnotationCopiedFrom = "shoot-cert-service.extensions.gardener.cloud/copied-from"
+	// LabelNextACMEAccountKey is the label on secrets with the new account key of a pending ACME account key rotation.
+	// Its value is the name of the issuer secret.
+	LabelNextACMEAccountKey = "shoot-cert-service.extensions.gardener.cloud/next-acme-account-key"
+	// AnnotationOperation is the annotation on the Extension resource to request an operation of the shoot cert service.
+	AnnotationOperation = "shoot-cert-service.extensions.gardener.cloud/operation"
+	// OperationRotateACMEAccountKeys is the operation to roll over the account keys of the auto-registered ACME issuers.
+	OperationRotateACMEAccountKeys = "rotate-acme-account-keys"
 	// FinalizerSuffix is the finalizer suffix for the shoot cert service controller.
 	FinalizerSuffix = "shoot-cert-service"
 )
@@ -15,8 +15,10 @@ import (
 
 	"github.com/gardener/gardener-extension-shoot-cert-service/imagevector"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+	configvalidation "github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config/validation"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
 	certv1alpha1 "github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/v1alpha1"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/validation"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/client"
 )
 
@@ -28,7 +30,22 @@ type Values struct {
 	Image                            string
 	GenericTokenKubeconfigSecretName string
 	RestrictedDomains                string
+	ShootDomain                      string
+	MissingShootDomain               bool
+	ComplianceProfile                *config.ComplianceProfile
 	Resources                        []gardencorev1beta1.NamedResourceReference
+	ClusterCAIssuer                  *Issuer
+	ClusterCABundle                  string
+	// ConfigurationOverrides are the names of the overrides applied to the extension configuration.
+	ConfigurationOverrides []string
+	// ActiveDefaultIssuer is the issuer selected from the issuer of the extension configuration and its fallback issuers.
+	// If empty, the issuer of the extension configuration is used.
+	ActiveDefaultIssuer string
+	// SeedKubeAPIServerHost is the address of the kube-apiserver service of the seed, which is excluded from the proxy.
+	SeedKubeAPIServerHost string
+	// ExtensionNamespace is the namespace of the extension, which contains the secrets referenced in the extension
+	// configuration without namespace.
+	ExtensionNamespace string
 
 	ShootDeployment        bool
 	GardenDeployment       bool
@@ -105,7 +122,32 @@ func (v Values) fullName() string {
 }
 
 func (v Values) RestrictedIssuer() bool {
-	return v.RestrictedDomains != "" && ptr.Deref(v.ExtensionConfig.RestrictIssuer, false)
+	return v.RestrictedDomains != "" && ptr.Deref(v.ExtensionConfig.RestrictIssuer, false) && !v.gardenIssuerDisabled()
+}
+
+func (v Values) defaultIssuerName() string {
+	if !v.ShootDeployment {
+		return v.ExtensionConfig.IssuerName
+	}
+	name := DefaultIssuerName(v.ExtensionConfig, v.CertConfig)
+	if name == v.ExtensionConfig.IssuerName && v.ActiveDefaultIssuer != "" && !v.gardenIssuerDisabled() {
+		return v.ActiveDefaultIssuer
+	}
+	return name
+}
+
+// FallbackIssuersEnabled checks if the fallback issuers of the default issuer are deployed for the shoot cluster.
+func (v Values) FallbackIssuersEnabled() bool {
+	return v.ShootDeployment && v.ExtensionConfig.DefaultIssuerFallback != nil && !v.gardenIssuerDisabled()
+}
+
+// gardenIssuerDisabled checks if the issuer of the service configuration is not deployed for the shoot cluster.
+// This is the case if it is disabled in the shoot manifest or if it is restricted and the shoot has no DNS domain.
+func (v Values) gardenIssuerDisabled() bool {
+	if !v.ShootDeployment {
+		return false
+	}
+	return v.MissingShootDomain || (v.CertConfig.DefaultIssuer != nil && v.CertConfig.DefaultIssuer.DisableGardenIssuer)
 }
 
 func (v Values) precheckNameservers() string {
@@ -126,6 +168,20 @@ func (v Values) caCertificates() string {
 	return ""
 }
 
+func (v Values) trustBundleEnabled() bool {
+	return v.CertConfig.TrustBundle != nil && v.CertConfig.TrustBundle.Enabled
+}
+
+func (v Values) trustBundleCopiesEnabled() bool {
+	return v.ShootDeployment && TrustBundleCopiesEnabled(&v.CertConfig)
+}
+
+// TrustBundleCopiesEnabled returns true if the trust bundle of the given configuration selects namespaces of the shoot
+// cluster for copies of the trust bundle config map.
+func TrustBundleCopiesEnabled(certConfig *service.CertConfig) bool {
+	return certConfig.TrustBundle != nil && certConfig.TrustBundle.Enabled && certConfig.TrustBundle.NamespaceSelector != nil
+}
+
 func (v Values) certExpirationAlertDays() int {
 	if v.CertConfig.Alerting != nil && v.CertConfig.Alerting.CertExpirationAlertDays != nil {
 		return *v.CertConfig.Alerting.CertExpirationAlertDays
@@ -133,7 +189,17 @@ func (v Values) certExpirationAlertDays() int {
 	return defaultCertExpirationAlertDays
 }
 
+func (v Values) shootACMESettings() *service.ACMESettings {
+	if !v.ShootDeployment || v.CertConfig.ACME == nil {
+		return &service.ACMESettings{}
+	}
+	return v.CertConfig.ACME
+}
+
 func (v Values) deactivateAuthorizations() bool {
+	if shoot := v.shootACMESettings().DeactivateAuthorizations; shoot != nil {
+		return *shoot
+	}
 	if v.ExtensionConfig.ACME == nil {
 		return false
 	}
@@ -141,12 +207,32 @@ func (v Values) deactivateAuthorizations() bool {
 }
 
 func (v Values) propagationTimeout() string {
+	if shoot := v.shootACMESettings().PropagationTimeout; shoot != nil {
+		return shoot.Duration.String()
+	}
 	if v.ExtensionConfig.ACME != nil && v.ExtensionConfig.ACME.PropagationTimeout != nil {
 		return v.ExtensionConfig.ACME.PropagationTimeout.Duration.String()
 	}
 	return ""
 }
 
+func (v Values) precheckAdditionalWait() string {
+	if shoot := v.shootACMESettings().PrecheckAdditionalWait; shoot != nil {
+		return shoot.Duration.String()
+	}
+	if v.ExtensionConfig.ACME != nil && v.ExtensionConfig.ACME.PrecheckAdditionalWait != nil {
+		return v.ExtensionConfig.ACME.PrecheckAdditionalWait.Duration.String()
+	}
+	return ""
+}
+
+func (v Values) privateKeyDefaults() (string, int, int) {
+	if !v.ShootDeployment {
+		return validation.PrivateKeyDefaults(&v.ExtensionConfig, nil)
+	}
+	return validation.PrivateKeyDefaults(&v.ExtensionConfig, &v.CertConfig)
+}
+
 func (v Values) priorityClassName() string {
 	if v.GardenDeployment {
 		return "gardener-garden-system-100"
@@ -158,16 +244,81 @@ func (v Values) shootIssuersEnabled() bool {
 	if !v.ShootDeployment {
 		return false
 	}
-	if v.CertConfig.ShootIssuers != nil {
-		return v.CertConfig.ShootIssuers.Enabled
+	return ShootIssuersEnabled(v.ExtensionConfig, v.CertConfig, v.ComplianceProfile)
+}
+
+// ShootIssuersEnabled returns the effective enablement of issuers on the shoot cluster.
+// The setting in the shoot manifest overwrites the one of the service configuration, unless the operator
+// forbids or forces issuers on shoot clusters by policy. Issuers on the shoot cluster are always disabled
+// if the compliance profile or the custom issuer policy restricts the issuer servers.
+func ShootIssuersEnabled(extensionConfig config.Configuration, certConfig service.CertConfig, profile *config.ComplianceProfile) bool {
+	if profile != nil && len(profile.AllowedIssuerServers) > 0 {
+		return false
+	}
+	if configvalidation.RestrictsIssuerServers(extensionConfig.CustomIssuerPolicy) {
+		return false
+	}
+	shootIssuers := extensionConfig.ShootIssuers
+	if shootIssuers != nil {
+		switch shootIssuers.Policy {
+		case config.ShootIssuersPolicyForbid:
+			return false
+		case config.ShootIssuersPolicyForce:
+			return true
+		}
+	}
+	if certConfig.ShootIssuers != nil {
+		return certConfig.ShootIssuers.Enabled
+	}
+	return shootIssuers != nil && shootIssuers.Enabled
+}
+
+// IssuerServerAllowed checks if the ACME server of the given issuer is allowed by the compliance profile of the shoot.
+// The server of an issuer of the shoot manifest, which does not reference an issuer template, must also be allowed by
+// the custom issuer policy.
+func (v Values) IssuerServerAllowed(issuer Issuer) bool {
+	if issuer.ACME == nil {
+		return true
+	}
+	if !configvalidation.IsIssuerServerAllowed(v.ComplianceProfile, issuer.ACME.Server) {
+		return false
+	}
+	gardenIssuer := issuer.Name == v.ExtensionConfig.IssuerName && !v.gardenIssuerDisabled()
+	policy := v.ExtensionConfig.CustomIssuerPolicy
+	if policy == nil || gardenIssuer || issuer.CatalogIssuer != "" || issuer.FallbackIssuer {
+		return true
+	}
+	if len(policy.AllowedServers) > 0 && !configvalidation.ContainsServerURL(policy.AllowedServers, issuer.ACME.Server) {
+		return false
+	}
+	return !configvalidation.ContainsServerURL(policy.DeniedServers, issuer.ACME.Server)
+}
+
+// DefaultIssuerName returns the name of the issuer used for certificates without explicit issuer.
+// It is the issuer selected in the shoot manifest or the issuer of the service configuration otherwise.
+func DefaultIssuerName(extensionConfig config.Configuration, certConfig service.CertConfig) string {
+	if certConfig.DefaultIssuer != nil && certConfig.DefaultIssuer.Name != "" {
+		return certConfig.DefaultIssuer.Name
 	}
-	return false
+	return extensionConfig.IssuerName
 }
 
 func (v Values) dnsChallengeOnShootEnabled() bool {
 	return v.ShootDeployment && v.CertConfig.DNSChallengeOnShoot != nil && v.CertConfig.DNSChallengeOnShoot.Enabled
 }
 
+func (v Values) secretReplicationEnabled() bool {
+	return v.ShootDeployment && v.CertConfig.SecretReplication != nil && v.CertConfig.SecretReplication.Enabled
+}
+
+func (v Values) csrSignerEnabled() bool {
+	return v.ShootDeployment && v.CertConfig.CSRSigner != nil && v.CertConfig.CSRSigner.Enabled
+}
+
+func (v Values) kubeAPIServerSNIEnabled() bool {
+	return v.ShootDeployment && v.ShootDomain != "" && v.CertConfig.KubeAPIServerSNI != nil && v.CertConfig.KubeAPIServerSNI.Enabled
+}
+
 type Deployer struct {
 	values Values
 }
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"fmt"
+
+	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
+	"github.com/gardener/cert-management/pkg/cert/source"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configvalidation "github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config/validation"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/v1alpha1"
+)
+
+// createIngressCertificate creates the certificate for the wildcard ingress domain of the shoot cluster.
+// The certificate is requested with the default issuer, as its domains are always subdomains of the shoot domain.
+func (d *Deployer) createIngressCertificate() []client.Object {
+	ingressCertificate := d.values.CertConfig.IngressCertificate
+	if ingressCertificate == nil || !ingressCertificate.Enabled || d.values.ShootDomain == "" {
+		return nil
+	}
+
+	dnsNames := []string{fmt.Sprintf("*.ingress.%s", d.values.ShootDomain)}
+	for _, subdomain := range ingressCertificate.AdditionalSubdomains {
+		dnsNames = append(dnsNames, fmt.Sprintf("*.%s.%s", subdomain, d.values.ShootDomain))
+	}
+
+	cert := d.newShootCertificate(v1alpha1.IngressCertificateSecretName, metav1.NamespaceSystem, dnsNames, v1alpha1.IngressCertificateSecretName)
+	d.applyIssuerDefaults(cert)
+	return []client.Object{cert}
+}
+
+// createKubeAPIServerSNICertificate creates the certificate for the domains of the kube-apiserver of the shoot cluster.
+// The certificate is part of the seed managed resource, so that the TLS secret for the SNI configuration is issued
+// directly into the control plane namespace and never passes through the shoot cluster.
+func (d *Deployer) createKubeAPIServerSNICertificate() []client.Object {
+	if !d.values.kubeAPIServerSNIEnabled() {
+		return nil
+	}
+
+	sni := d.values.CertConfig.KubeAPIServerSNI
+	dnsNames := append([]string{fmt.Sprintf("api.%s", d.values.ShootDomain)}, sni.AdditionalDomains...)
+	cert := &certv1alpha1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      v1alpha1.KubeAPIServerSNICertificateName,
+			Namespace: d.values.Namespace,
+			Labels:    d.values.getLabels(),
+		},
+		Spec: certv1alpha1.CertificateSpec{
+			DNSNames: dnsNames,
+			SecretRef: &corev1.SecretReference{
+				Name:      v1alpha1.KubeAPIServerSNISecretName,
+				Namespace: d.values.Namespace,
+			},
+		},
+	}
+	d.applyIssuerDefaults(cert)
+	return []client.Object{cert}
+}
+
+// createCertificates creates the certificates declared in the provider config for the shoot cluster.
+func (d *Deployer) createCertificates() []client.Object {
+	var objects []client.Object
+	for _, input := range d.values.CertConfig.Certificates {
+		cert := d.newShootCertificate(input.Name, ptr.Deref(input.SecretNamespace, metav1.NamespaceDefault), input.DNSNames, input.SecretName)
+		if input.IssuerName != nil {
+			cert.Spec.IssuerRef = &certv1alpha1.IssuerRef{Name: *input.IssuerName}
+		}
+		if input.PrivateKey != nil {
+			cert.Spec.PrivateKey = &certv1alpha1.CertificatePrivateKey{
+				Algorithm: (*certv1alpha1.PrivateKeyAlgorithm)(input.PrivateKey.Algorithm),
+				Size:      (*certv1alpha1.PrivateKeySize)(input.PrivateKey.Size),
+			}
+		}
+		cert.Spec.Duration = input.Duration
+		d.applyIssuerDefaults(cert)
+		objects = append(objects, cert)
+	}
+	return objects
+}
+
+func (d *Deployer) newShootCertificate(name, namespace string, dnsNames []string, secretName string) *certv1alpha1.Certificate {
+	cert := &certv1alpha1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    d.getShootLabels(),
+		},
+		Spec: certv1alpha1.CertificateSpec{
+			DNSNames: dnsNames,
+			SecretRef: &corev1.SecretReference{
+				Name:      secretName,
+				Namespace: namespace,
+			},
+		},
+	}
+	if d.values.CertClass != "" {
+		cert.Annotations = map[string]string{source.AnnotClass: d.values.CertClass}
+	}
+	return cert
+}
+
+// applyIssuerDefaults sets the preferred chain and the private key of a certificate from the settings of its issuer,
+// which is the default issuer if no issuer is referenced. A private key specified for the certificate is kept.
+func (d *Deployer) applyIssuerDefaults(cert *certv1alpha1.Certificate) {
+	preferredChain, keyType := d.issuerDefaults(cert.Spec.IssuerRef)
+	cert.Spec.PreferredChain = preferredChain
+	if cert.Spec.PrivateKey == nil && keyType != nil {
+		if algorithm, size, err := configvalidation.ParseKeyType(*keyType); err == nil {
+			cert.Spec.PrivateKey = &certv1alpha1.CertificatePrivateKey{
+				Algorithm: ptr.To(certv1alpha1.PrivateKeyAlgorithm(algorithm)),
+				Size:      ptr.To(certv1alpha1.PrivateKeySize(size)), // #nosec G115 -- the sizes of the key types fit into int32
+			}
+		}
+	}
+}
+
+// issuerDefaults returns the preferred chain and the key type of the issuer of a certificate, which is the default issuer
+// if no issuer is referenced.
+func (d *Deployer) issuerDefaults(issuerRef *certv1alpha1.IssuerRef) (*string, *string) {
+	name := d.values.defaultIssuerName()
+	if issuerRef != nil {
+		name = issuerRef.Name
+	}
+	if name == d.values.ExtensionConfig.IssuerName {
+		if acme := d.values.ExtensionConfig.ACME; acme != nil {
+			return acme.PreferredChain, acme.KeyType
+		}
+		return nil, nil
+	}
+	for _, issuer := range d.values.CertConfig.Issuers {
+		if issuer.Name == name {
+			return issuer.PreferredChain, issuer.KeyType
+		}
+	}
+	return nil, nil
+}
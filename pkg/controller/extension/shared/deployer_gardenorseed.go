@@ -9,6 +9,7 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
@@ -20,7 +21,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configvalidation "github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config/validation"
 )
 
 func (d *Deployer) DeployGardenOrSeedManagedResource(ctx context.Context, c client.Client) error {
@@ -68,6 +72,9 @@ func (d *Deployer) DeployGardenOrSeedManagedResource(ctx context.Context, c clie
 		return err
 	}
 
+	if err := d.deployIssuerSecretCopies(ctx, c, issuers); err != nil {
+		return err
+	}
 	if err := d.validateIssuerSecrets(ctx, c, issuers); err != nil {
 		return fmt.Errorf("failed to validate issuer secrets: %w", err)
 	}
@@ -84,7 +91,10 @@ func (d *Deployer) DeleteGardenOrSeedManagedResourceAndWait(ctx context.Context,
 
 	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	return managedresources.WaitUntilDeleted(timeoutCtx, c, d.values.Namespace, d.values.resourceNameGardenOrSeed())
+	if err := managedresources.WaitUntilDeleted(timeoutCtx, c, d.values.Namespace, d.values.resourceNameGardenOrSeed()); err != nil {
+		return err
+	}
+	return d.deleteIssuerSecretCopies(ctx, c, nil)
 }
 
 func (d *Deployer) createNetworkPolicy() *networkingv1.NetworkPolicy {
@@ -122,6 +132,9 @@ func (d *Deployer) validateIssuerSecrets(ctx context.Context, c client.Client, i
 		if issuer.ACME != nil {
 			errs = append(errs, d.validateACMEIssuerSecret(ctx, c, issuer)...)
 		}
+		if issuer.CA != nil && issuer.CA.PrivateKeySecretName != "" {
+			errs = append(errs, d.validateCAIssuerSecret(ctx, c, issuer)...)
+		}
 	}
 	return errors.Join(errs...)
 }
@@ -155,5 +168,52 @@ func (d *Deployer) validateACMEIssuerSecret(ctx context.Context, c client.Client
 			errs = append(errs, fmt.Errorf("failed to validate EAB key secret for issuer %s: %w", issuer.Name, err))
 		}
 	}
+	if issuer.ACME.CACertificates != nil {
+		if err := d.validateIssuerCACertificates(ctx, c, issuer.ACME.CACertificates); err != nil {
+			errs = append(errs, fmt.Errorf("failed to validate CA certificates for issuer %s: %w", issuer.Name, err))
+		}
+	}
 	return errs
 }
+
+func (d *Deployer) validateIssuerCACertificates(ctx context.Context, c client.Client, ref *ReferencedResource) error {
+	_, err := d.issuerCACertificates(ctx, c, ref)
+	return err
+}
+
+// issuerCACertificates reads and validates the custom root certificates of an ACME issuer from the referenced resource.
+func (d *Deployer) issuerCACertificates(ctx context.Context, c client.Client, ref *ReferencedResource) (string, error) {
+	var data string
+	key := client.ObjectKey{Namespace: d.values.Namespace, Name: ref.Name}
+	if ref.Kind == "ConfigMap" {
+		configMap := &corev1.ConfigMap{}
+		if err := c.Get(ctx, key, configMap); err != nil {
+			return "", err
+		}
+		data = configMap.Data[issuerCACertificatesDataKey]
+	} else {
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, key, secret); err != nil {
+			return "", err
+		}
+		data = string(secret.Data[issuerCACertificatesDataKey])
+	}
+	if strings.TrimSpace(data) == "" {
+		return "", fmt.Errorf("data key %s is missing or empty", issuerCACertificatesDataKey)
+	}
+	if err := configvalidation.ValidateCACertificates(field.NewPath(issuerCACertificatesDataKey), data); err != nil {
+		return "", err
+	}
+	return data, nil
+}
+
+func (d *Deployer) validateCAIssuerSecret(ctx context.Context, c client.Client, issuer Issuer) []error {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: d.values.Namespace, Name: issuer.CA.PrivateKeySecretName}, secret); err != nil {
+		return []error{fmt.Errorf("failed to read CA secret for issuer %s: %w", issuer.Name, err)}
+	}
+	if _, err := legobridge.CAKeyPairFromSecretData(secret.Data); err != nil {
+		return []error{fmt.Errorf("failed to validate CA secret for issuer %s: %w", issuer.Name, err)}
+	}
+	return nil
+}
@@ -7,6 +7,8 @@ package shared
 import (
 	"crypto/sha256"
 	"fmt"
+	"slices"
+	"strings"
 
 	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
 	"github.com/gardener/cert-management/pkg/cert/source"
@@ -16,9 +18,17 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/validation"
 )
 
 func (d *Deployer) collectIssuers() ([]Issuer, error) {
+	if _, err := ReferencedSecrets(d.values.ExtensionConfig, d.values.ExtensionNamespace); err != nil {
+		return nil, err
+	}
+
 	gardenIssuer := Issuer{Name: d.values.ExtensionConfig.IssuerName}
 	if acme := d.values.ExtensionConfig.ACME; acme != nil {
 		gardenIssuer.ACME = &ACME{
@@ -27,22 +37,70 @@ func (d *Deployer) collectIssuers() ([]Issuer, error) {
 			PrivateKey:                 acme.PrivateKey,
 			SkipDNSChallengeValidation: ptr.Deref(acme.SkipDNSChallengeValidation, false),
 		}
+		if acme.PrivateKeySecretRef != nil {
+			gardenIssuer.ACME.PrivateKeySecretName, gardenIssuer.ACME.SourceSecret = d.referencedIssuerSecret(acme.PrivateKeySecretRef, gardenIssuer.Name)
+		}
+		if eab := acme.ExternalAccountBinding; eab != nil && eab.KeySecretRef != nil {
+			gardenIssuer.ACME.ExternalAccountBinding = &ExternalAccountBinding{KeyID: eab.KeyID}
+			gardenIssuer.ACME.ExternalAccountBinding.KeySecretName, gardenIssuer.ACME.ExternalAccountBinding.SourceSecret = d.referencedIssuerSecret(eab.KeySecretRef, gardenIssuer.Name+"-eab")
+		}
+		if acme.Domains != nil && len(acme.Domains.Include)+len(acme.Domains.Exclude) > 0 {
+			gardenIssuer.ACME.Domains = &Domains{
+				Include: acme.Domains.Include,
+				Exclude: acme.Domains.Exclude,
+			}
+		}
 	}
 	if ca := d.values.ExtensionConfig.CA; ca != nil {
-		gardenIssuer.CA = &CA{
-			Certificate:    ca.Certificate,
-			CertificateKey: ca.CertificateKey,
-		}
+		gardenIssuer.CA = d.caModel(gardenIssuer.Name, ca)
 	}
 
-	issuerList := []Issuer{gardenIssuer}
+	var issuerList []Issuer
+	if !d.values.gardenIssuerDisabled() {
+		issuerList = append(issuerList, gardenIssuer)
+	}
+	if d.values.FallbackIssuersEnabled() {
+		for _, fallback := range d.values.ExtensionConfig.DefaultIssuerFallback.Issuers {
+			modelIssuer := d.catalogIssuerModel(fallback.Name, fallback)
+			modelIssuer.FallbackIssuer = true
+			if modelIssuer.ACME != nil && d.values.RestrictedIssuer() {
+				modelIssuer.ACME.Domains = d.restrictedDomains(modelIssuer.ACME.Domains)
+			}
+			issuerList = append(issuerList, *modelIssuer)
+		}
+	}
 
 	if !d.values.ShootDeployment {
 		return issuerList, nil
 	}
 
 	for _, issuer := range d.values.CertConfig.Issuers {
-		if issuer.Name == d.values.ExtensionConfig.IssuerName {
+		if issuer.Name == d.values.ExtensionConfig.IssuerName && !d.values.gardenIssuerDisabled() {
+			continue
+		}
+
+		if issuer.CatalogIssuer != nil {
+			modelIssuer, err := d.catalogIssuer(issuer)
+			if err != nil {
+				return nil, err
+			}
+			issuerList = append(issuerList, *modelIssuer)
+			continue
+		}
+
+		if issuer.CA != nil {
+			secretName, err := d.lookupReferencedSecret(issuer.CA.SecretName)
+			if err != nil {
+				return nil, fmt.Errorf("failed to lookup referenced CA secret for issuer %s: %w", issuer.Name, err)
+			}
+			modelIssuer := Issuer{
+				Name: issuer.Name,
+				CA:   &CA{PrivateKeySecretName: secretName},
+			}
+			if issuer.RequestsPerDayQuota != nil {
+				modelIssuer.RequestsPerDayQuota = *issuer.RequestsPerDayQuota
+			}
+			issuerList = append(issuerList, modelIssuer)
 			continue
 		}
 
@@ -70,6 +128,13 @@ func (d *Deployer) collectIssuers() ([]Issuer, error) {
 		if issuer.SkipDNSChallengeValidation != nil && *issuer.SkipDNSChallengeValidation {
 			acme.SkipDNSChallengeValidation = true
 		}
+		if issuer.CACertificatesResourceName != nil {
+			name, kind, err := d.lookupReferencedResource(*issuer.CACertificatesResourceName, "Secret", "ConfigMap")
+			if err != nil {
+				return nil, fmt.Errorf("failed to lookup referenced CA certificates resource for issuer %s: %w", issuer.Name, err)
+			}
+			acme.CACertificates = &ReferencedResource{Kind: kind, Name: name}
+		}
 		if issuer.Domains != nil && len(issuer.Domains.Include)+len(issuer.Domains.Exclude) > 0 {
 			acme.Domains = &Domains{}
 			if issuer.Domains.Include != nil {
@@ -93,14 +158,140 @@ func (d *Deployer) collectIssuers() ([]Issuer, error) {
 		issuerList = append(issuerList, modelIssuer)
 	}
 
+	if d.values.ClusterCAIssuer != nil {
+		issuerList = append(issuerList, *d.values.ClusterCAIssuer)
+	}
+
 	return issuerList, nil
 }
 
-func (d *Deployer) issuersChecksum() (string, error) {
+// AutoRegisteredACMEIssuers returns the ACME issuers whose account is registered by the cert-controller-manager.
+func (d *Deployer) AutoRegisteredACMEIssuers() ([]Issuer, error) {
 	issuers, err := d.collectIssuers()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	var result []Issuer
+	for _, issuer := range issuers {
+		if issuer.ACME != nil && issuer.ACME.AutoRegistration() {
+			result = append(result, issuer)
+		}
+	}
+	return result, nil
+}
+
+// catalogIssuer creates the issuer model for an issuer of the shoot manifest referencing an issuer template of the issuer catalog.
+// The credentials of the template are deployed as secrets in the shoot namespace.
+func (d *Deployer) catalogIssuer(issuer service.IssuerConfig) (*Issuer, error) {
+	catalogIssuer := validation.CatalogIssuer(&d.values.ExtensionConfig, *issuer.CatalogIssuer)
+	if catalogIssuer == nil {
+		return nil, fmt.Errorf("catalog issuer %s of issuer %s not found", *issuer.CatalogIssuer, issuer.Name)
+	}
+
+	modelIssuer := d.catalogIssuerModel(issuer.Name, *catalogIssuer)
+	modelIssuer.CatalogIssuer = catalogIssuer.Name
+	modelIssuer.RequestsPerDayQuota = ptr.Deref(issuer.RequestsPerDayQuota, 0)
+	if modelIssuer.ACME != nil && issuer.Domains != nil && len(issuer.Domains.Include)+len(issuer.Domains.Exclude) > 0 {
+		modelIssuer.ACME.Domains = mergeCatalogDomains(modelIssuer.ACME.Domains, issuer.Domains.Include, issuer.Domains.Exclude)
+	}
+	return modelIssuer, nil
+}
+
+// mergeCatalogDomains merges the domains of an issuer in the shoot manifest into the domains of its issuer template.
+// The included domains of the shoot issuer have been validated to be within the included domains of the template, so
+// they replace them. The excluded domains of both are kept.
+func mergeCatalogDomains(catalogDomains *Domains, include, exclude []string) *Domains {
+	if catalogDomains == nil {
+		return &Domains{Include: include, Exclude: exclude}
+	}
+	result := &Domains{Include: catalogDomains.Include, Exclude: slices.Clone(catalogDomains.Exclude)}
+	if len(include) > 0 {
+		result.Include = include
+	}
+	for _, domain := range exclude {
+		if !slices.Contains(result.Exclude, domain) {
+			result.Exclude = append(result.Exclude, domain)
+		}
+	}
+	return result
+}
+
+// catalogIssuerModel creates the issuer model with the given name for an issuer template of the operator.
+// The referenced secrets of the template are copied to the namespace of the deployment.
+func (d *Deployer) catalogIssuerModel(name string, catalogIssuer config.CatalogIssuer) *Issuer {
+	modelIssuer := &Issuer{Name: name}
+	if ca := catalogIssuer.CA; ca != nil {
+		modelIssuer.CA = d.caModel(name, ca)
+		return modelIssuer
+	}
+	if acme := catalogIssuer.ACME; acme != nil {
+		modelIssuer.ACME = &ACME{
+			Email:                      acme.Email,
+			Server:                     acme.Server,
+			SkipDNSChallengeValidation: ptr.Deref(acme.SkipDNSChallengeValidation, false),
+		}
+		if acme.PrivateKeySecretRef != nil {
+			modelIssuer.ACME.PrivateKeySecretName, modelIssuer.ACME.SourceSecret = d.referencedIssuerSecret(acme.PrivateKeySecretRef, name)
+		}
+		if eab := acme.ExternalAccountBinding; eab != nil && eab.KeySecretRef != nil {
+			modelIssuer.ACME.ExternalAccountBinding = &ExternalAccountBinding{KeyID: eab.KeyID}
+			modelIssuer.ACME.ExternalAccountBinding.KeySecretName, modelIssuer.ACME.ExternalAccountBinding.SourceSecret = d.referencedIssuerSecret(eab.KeySecretRef, name+"-eab")
+		}
+		if acme.Domains != nil && len(acme.Domains.Include)+len(acme.Domains.Exclude) > 0 {
+			modelIssuer.ACME.Domains = &Domains{
+				Include: acme.Domains.Include,
+				Exclude: acme.Domains.Exclude,
+			}
+		}
+		modelIssuer.PrecheckNameservers = acme.PrecheckNameservers
+	}
+	return modelIssuer
+}
+
+// restrictedDomains restricts the given domains of a fallback issuer to the domains of the shoot.
+// The garden issuer is restricted by the domain ranges of the default issuer, but the fallback issuers
+// can be referenced by the certificates of the shoot even if they are not the default issuer.
+func (d *Deployer) restrictedDomains(domains *Domains) *Domains {
+	restricted := strings.Split(d.values.RestrictedDomains, ",")
+	result := &Domains{Include: restricted}
+	if domains == nil {
+		return result
+	}
+	result.Exclude = domains.Exclude
+	if len(domains.Include) > 0 {
+		// only the domains included by both the restriction and the issuer are allowed
+		result.Include = nil
+		for _, domain := range restricted {
+			if isSubdomainOfAny(domain, domains.Include) {
+				result.Include = append(result.Include, domain)
+			}
+		}
+		for _, domain := range domains.Include {
+			if isSubdomainOfAny(domain, restricted) && !slices.Contains(result.Include, domain) {
+				result.Include = append(result.Include, domain)
+			}
+		}
+		if len(result.Include) == 0 {
+			// the issuer must not be usable for any domain of the shoot
+			result.Include = restricted
+			result.Exclude = append(slices.Clone(domains.Exclude), restricted...)
+		}
+	}
+	return result
+}
+
+func isSubdomainOfAny(domain string, parents []string) bool {
+	domain = strings.ToLower(domain)
+	for _, parent := range parents {
+		parent = strings.ToLower(parent)
+		if domain == parent || strings.HasSuffix(domain, "."+parent) {
+			return true
+		}
+	}
+	return false
+}
+
+func issuersChecksum(issuers []Issuer) (string, error) {
 	issuersData, err := yaml.Marshal(issuers)
 	if err != nil {
 		return "", err
@@ -120,7 +311,7 @@ func (d *Deployer) createIssuers() ([]client.Object, []Issuer, error) {
 		if issuer.ACME != nil && issuer.ACME.PrivateKey != nil {
 			objects = append(objects, d.secretACME(issuer))
 		}
-		if issuer.CA != nil {
+		if issuer.CA != nil && issuer.CA.PrivateKeySecretName == "" {
 			objects = append(objects, d.secretCA(issuer))
 		}
 		objects = append(objects, d.createIssuer(issuer))
@@ -170,6 +361,12 @@ func (d *Deployer) createIssuer(input Issuer) *certv1alpha1.Issuer {
 	if d.values.CertClass != "" {
 		issuer.Annotations = map[string]string{source.AnnotClass: d.values.CertClass}
 	}
+	if input.CatalogIssuer != "" {
+		issuer.Labels = map[string]string{LabelCatalogIssuer: input.CatalogIssuer}
+	}
+	if input.FallbackIssuer {
+		issuer.Labels = map[string]string{LabelFallbackIssuer: "true"}
+	}
 	if input.RequestsPerDayQuota > 0 {
 		issuer.Spec.RequestsPerDayQuota = &input.RequestsPerDayQuota
 	}
@@ -192,7 +389,7 @@ func (d *Deployer) createACMESpec(issuer Issuer) *certv1alpha1.ACMESpec {
 			Name:      secretName,
 			Namespace: d.values.Namespace,
 		},
-		AutoRegistration:    input.PrivateKeySecretName == "" && input.PrivateKey == nil,
+		AutoRegistration:    input.AutoRegistration(),
 		PrecheckNameservers: issuer.PrecheckNameservers,
 	}
 	if input.ExternalAccountBinding != nil {
@@ -221,26 +418,37 @@ func (d *Deployer) createCASpec(issuer Issuer) *certv1alpha1.CASpec {
 	if issuer.CA == nil {
 		return nil
 	}
+	secretName := issuer.CA.PrivateKeySecretName
+	if secretName == "" {
+		secretName = fmt.Sprintf("extension-shoot-cert-service-issuer-%s-ca", issuer.Name)
+	}
 	return &certv1alpha1.CASpec{
 		PrivateKeySecretRef: &corev1.SecretReference{
-			Name:      fmt.Sprintf("extension-shoot-cert-service-issuer-%s-ca", issuer.Name),
+			Name:      secretName,
 			Namespace: d.values.Namespace,
 		},
 	}
 }
 
 func (d *Deployer) lookupReferencedSecret(refname string) (string, error) {
+	name, _, err := d.lookupReferencedResource(refname, "Secret")
+	return name, err
+}
+
+// lookupReferencedResource returns the name and kind of the copy of a resource referenced in the shoot spec in the
+// namespace of the deployment. The kind of the resource must be one of the given kinds.
+func (d *Deployer) lookupReferencedResource(refname string, kinds ...string) (string, string, error) {
 	if !d.values.ShootDeployment {
-		return "invalid", fmt.Errorf("only shoot deployment supports additional issuers")
+		return "invalid", "", fmt.Errorf("only shoot deployment supports additional issuers")
 	}
 	for _, ref := range d.values.Resources {
 		if ref.Name == refname {
-			if ref.ResourceRef.Kind != "Secret" {
-				return "invalid-kind", fmt.Errorf("invalid referenced resource, expected kind Secret, not %s: %s", ref.ResourceRef.Kind, refname)
+			if !slices.Contains(kinds, ref.ResourceRef.Kind) {
+				return "invalid-kind", "", fmt.Errorf("invalid referenced resource, expected kind %s, not %s: %s", strings.Join(kinds, " or "), ref.ResourceRef.Kind, refname)
 			}
-			return v1beta1constants.ReferencedResourcesPrefix + ref.ResourceRef.Name, nil
+			return v1beta1constants.ReferencedResourcesPrefix + ref.ResourceRef.Name, ref.ResourceRef.Kind, nil
 		}
 	}
 
-	return "invalid", fmt.Errorf("invalid referenced resource: %s", refname)
+	return "invalid", "", fmt.Errorf("invalid referenced resource: %s", refname)
 }
@@ -9,6 +9,8 @@ import (
 	_ "embed"
 	"fmt"
 	"maps"
+	"path"
+	"strings"
 	"time"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -38,14 +40,19 @@ const (
 	issuerWorkers      = 2
 	vpaUpdateMode      = "InPlaceOrRecreate"
 
-	defaultAlgorithm               = "RSA"
-	defaultSizeRSA                 = 3072
-	defaultSizeECDSA               = 384
 	defaultCertExpirationAlertDays = 15
 
 	shootAccessSecretName = gutil.SecretNamePrefixShootAccess + v1alpha1.ShootAccessSecretName
 
 	nextGenerationDNSClass = "gardendns-next-gen"
+
+	// issuerCACertificatesMountPath is the mount path of the custom root certificates of the issuers of the shoot manifest.
+	issuerCACertificatesMountPath = "/var/run/cert-manager/issuer-certs"
+	// issuerCACertificatesDataKey is the data key of the custom root certificates in the referenced secret or config map.
+	issuerCACertificatesDataKey = "ca.crt"
+
+	// defaultNoProxy contains the cluster internal addresses excluded from the proxy.
+	defaultNoProxy = "localhost,127.0.0.1,kube-apiserver,.svc,.cluster.local"
 )
 
 var (
@@ -70,6 +77,7 @@ func (d *Deployer) DeploySeedManagedResource(ctx context.Context, c client.Clien
 		return err
 	}
 	objects = append(objects, issuerObjects...)
+	objects = append(objects, d.createKubeAPIServerSNICertificate()...)
 	objects = append(objects, d.createRole())
 	objects = append(objects, d.createRoleBinding())
 	objects = append(objects, d.createService())
@@ -90,6 +98,9 @@ func (d *Deployer) DeploySeedManagedResource(ctx context.Context, c client.Clien
 		return err
 	}
 
+	if err := d.deployIssuerSecretCopies(ctx, c, issuers); err != nil {
+		return err
+	}
 	if err := d.validateIssuerSecrets(ctx, c, issuers); err != nil {
 		return fmt.Errorf("failed to validate issuer secrets: %w", err)
 	}
@@ -110,7 +121,10 @@ func (d *Deployer) DeleteSeedManagedResourceAndWait(ctx context.Context, c clien
 
 	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	return managedresources.WaitUntilDeleted(timeoutCtx, c, d.values.Namespace, v1alpha1.CertManagementResourceNameSeed)
+	if err := managedresources.WaitUntilDeleted(timeoutCtx, c, d.values.Namespace, v1alpha1.CertManagementResourceNameSeed); err != nil {
+		return err
+	}
+	return d.deleteIssuerSecretCopies(ctx, c, nil)
 }
 
 func (d *Deployer) createCACertificatesConfigMap() *corev1.ConfigMap {
@@ -160,7 +174,11 @@ func (d *Deployer) createDeployment() (*appsv1.Deployment, error) {
 	}
 	maps.Copy(podLabels, d.values.getLabels())
 
-	issuerChecksum, err := d.issuersChecksum()
+	issuers, err := d.collectIssuers()
+	if err != nil {
+		return nil, err
+	}
+	issuerChecksum, err := issuersChecksum(issuers)
 	if err != nil {
 		return nil, err
 	}
@@ -194,9 +212,9 @@ func (d *Deployer) createDeployment() (*appsv1.Deployment, error) {
 							SecurityContext: &corev1.SecurityContext{
 								AllowPrivilegeEscalation: new(false),
 							},
-							VolumeMounts: d.volumeMounts(),
+							VolumeMounts: d.volumeMounts(issuers),
 							Args:         d.args(),
-							Env:          d.env(),
+							Env:          d.env(issuers),
 							Ports: []corev1.ContainerPort{
 								{
 									Name:          "metrics",
@@ -223,7 +241,7 @@ func (d *Deployer) createDeployment() (*appsv1.Deployment, error) {
 							},
 						},
 					},
-					Volumes:            d.volumes(),
+					Volumes:            d.volumes(issuers),
 					ServiceAccountName: d.values.chartNameSeed(),
 				},
 			},
@@ -341,6 +359,13 @@ func (d *Deployer) createRole() *rbacv1.Role {
 			Verbs:     []string{"create", "patch"},
 		},
 	)
+	if d.values.kubeAPIServerSNIEnabled() {
+		role.Rules = append(role.Rules, rbacv1.PolicyRule{
+			APIGroups: []string{"cert.gardener.cloud"},
+			Resources: []string{"certificates", "certificates/status"},
+			Verbs:     []string{"get", "list", "update", "patch", "watch"},
+		})
+	}
 	return role
 }
 
@@ -474,20 +499,30 @@ func (d *Deployer) createVPA() *vpaautoscalingv1.VerticalPodAutoscaler {
 	}
 }
 
-func (d *Deployer) env() []corev1.EnvVar {
-	if d.values.caCertificates() == "" {
-		return nil
+func (d *Deployer) env(issuers []Issuer) []corev1.EnvVar {
+	var env []corev1.EnvVar
+	if files := d.caCertificatesFiles(issuers); len(files) > 0 {
+		env = append(env,
+			corev1.EnvVar{
+				Name:  "LEGO_CA_SYSTEM_CERT_POOL",
+				Value: "true",
+			},
+			corev1.EnvVar{
+				Name:  "LEGO_CA_CERTIFICATES",
+				Value: strings.Join(files, ":"),
+			},
+		)
 	}
-	return []corev1.EnvVar{
-		{
-			Name:  "LEGO_CA_SYSTEM_CERT_POOL",
-			Value: "true",
-		},
-		{
-			Name:  "LEGO_CA_CERTIFICATES",
-			Value: "/var/run/cert-manager/certs/certs.pem",
-		},
+	if proxy := d.values.ExtensionConfig.Proxy; proxy != nil {
+		if proxy.HTTPProxy != nil {
+			env = append(env, corev1.EnvVar{Name: "HTTP_PROXY", Value: *proxy.HTTPProxy})
+		}
+		if proxy.HTTPSProxy != nil {
+			env = append(env, corev1.EnvVar{Name: "HTTPS_PROXY", Value: *proxy.HTTPSProxy})
+		}
+		env = append(env, corev1.EnvVar{Name: "NO_PROXY", Value: d.values.noProxy()})
 	}
+	return env
 }
 
 func (d *Deployer) args() []string {
@@ -505,7 +540,7 @@ func (d *Deployer) args() []string {
 	}
 	args = append(args,
 		fmt.Sprintf("--issuer.issuer-namespace=%s", d.values.Namespace),
-		fmt.Sprintf("--issuer.default-issuer=%s", d.values.ExtensionConfig.IssuerName))
+		fmt.Sprintf("--issuer.default-issuer=%s", d.values.defaultIssuerName()))
 	if quota := ptr.Deref(d.values.ExtensionConfig.DefaultRequestsPerDayQuota, 0); quota > 0 {
 		args = append(args, fmt.Sprintf("--issuer.default-requests-per-day-quota=%d", quota))
 	}
@@ -533,6 +568,9 @@ func (d *Deployer) args() []string {
 	if timeout := d.values.propagationTimeout(); timeout != "" {
 		args = append(args, fmt.Sprintf("--propagation-timeout=%s", timeout))
 	}
+	if wait := d.values.precheckAdditionalWait(); wait != "" {
+		args = append(args, fmt.Sprintf("--precheck-additional-wait=%s", wait))
+	}
 	if d.values.shootIssuersEnabled() {
 		args = append(args, "--allow-target-issuers")
 	}
@@ -546,14 +584,7 @@ func (d *Deployer) args() []string {
 		"--source.disable-deploy-crds",
 		"--target.disable-deploy-crds")
 
-	algorithm := defaultAlgorithm
-	sizeRSA := defaultSizeRSA
-	sizeECDSA := defaultSizeECDSA
-	if d.values.ExtensionConfig.PrivateKeyDefaults != nil {
-		algorithm = ptr.Deref(d.values.ExtensionConfig.PrivateKeyDefaults.Algorithm, algorithm)
-		sizeRSA = ptr.Deref(d.values.ExtensionConfig.PrivateKeyDefaults.SizeRSA, sizeRSA)
-		sizeECDSA = ptr.Deref(d.values.ExtensionConfig.PrivateKeyDefaults.SizeECDSA, sizeECDSA)
-	}
+	algorithm, sizeRSA, sizeECDSA := d.values.privateKeyDefaults()
 	args = append(args,
 		fmt.Sprintf("--default-private-key-algorithm=%s", algorithm),
 		fmt.Sprintf("--default-rsa-private-key-size=%d", sizeRSA),
@@ -567,7 +598,31 @@ func (d *Deployer) args() []string {
 	return args
 }
 
-func (d *Deployer) volumeMounts() []corev1.VolumeMount {
+// caCertificatesFiles returns the paths of the custom root certificates of the ACME servers in the container.
+// The certificates of the ACME configuration apply to all issuers, the certificates of the issuers of the shoot manifest
+// are mounted alongside.
+func (d *Deployer) caCertificatesFiles(issuers []Issuer) []string {
+	var files []string
+	if d.values.caCertificates() != "" {
+		files = append(files, "/var/run/cert-manager/certs/certs.pem")
+	}
+	for _, issuer := range issuersWithCACertificates(issuers) {
+		files = append(files, path.Join(issuerCACertificatesMountPath, issuer.Name+".pem"))
+	}
+	return files
+}
+
+func issuersWithCACertificates(issuers []Issuer) []Issuer {
+	var result []Issuer
+	for _, issuer := range issuers {
+		if issuer.ACME != nil && issuer.ACME.CACertificates != nil {
+			result = append(result, issuer)
+		}
+	}
+	return result
+}
+
+func (d *Deployer) volumeMounts(issuers []Issuer) []corev1.VolumeMount {
 	var mounts []corev1.VolumeMount
 	if d.values.ShootDeployment {
 		mounts = append(mounts, corev1.VolumeMount{
@@ -583,10 +638,17 @@ func (d *Deployer) volumeMounts() []corev1.VolumeMount {
 			ReadOnly:  true,
 		})
 	}
+	if len(issuersWithCACertificates(issuers)) > 0 {
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      "issuer-ca-certificates",
+			MountPath: issuerCACertificatesMountPath,
+			ReadOnly:  true,
+		})
+	}
 	return mounts
 }
 
-func (d *Deployer) volumes() []corev1.Volume {
+func (d *Deployer) volumes(issuers []Issuer) []corev1.Volume {
 	var volumes []corev1.Volume
 	if d.values.ShootDeployment {
 		volumes = append(volumes, corev1.Volume{
@@ -640,6 +702,33 @@ func (d *Deployer) volumes() []corev1.Volume {
 			},
 		})
 	}
+	if withCACertificates := issuersWithCACertificates(issuers); len(withCACertificates) > 0 {
+		var sources []corev1.VolumeProjection
+		for _, issuer := range withCACertificates {
+			ref := issuer.ACME.CACertificates
+			items := []corev1.KeyToPath{{Key: issuerCACertificatesDataKey, Path: issuer.Name + ".pem"}}
+			if ref.Kind == "ConfigMap" {
+				sources = append(sources, corev1.VolumeProjection{ConfigMap: &corev1.ConfigMapProjection{
+					LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+					Items:                items,
+				}})
+			} else {
+				sources = append(sources, corev1.VolumeProjection{Secret: &corev1.SecretProjection{
+					LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+					Items:                items,
+				}})
+			}
+		}
+		volumes = append(volumes, corev1.Volume{
+			Name: "issuer-ca-certificates",
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					DefaultMode: ptr.To[int32](420),
+					Sources:     sources,
+				},
+			},
+		})
+	}
 	return volumes
 }
 
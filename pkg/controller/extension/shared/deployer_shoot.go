@@ -55,6 +55,17 @@ func (d *Deployer) DeployShootManagedResource(ctx context.Context, c client.Clie
 	}
 	objects = append(objects, crds...)
 
+	trustBundleConfigMap, err := d.createTrustBundleConfigMap(ctx, c)
+	if err != nil {
+		return err
+	}
+	if trustBundleConfigMap != nil {
+		objects = append(objects, trustBundleConfigMap)
+	}
+
+	objects = append(objects, d.createIngressCertificate()...)
+	objects = append(objects, d.createCertificates()...)
+
 	registry := newManagedResourceRegistry()
 	data, err := registry.AddAllAndSerialize(objects...)
 	if err != nil {
@@ -204,6 +215,43 @@ func (d *Deployer) createShootClusterRole() *rbacv1.ClusterRole {
 			Verbs:     []string{"get", "list", "update", "watch", "create", "delete"},
 		})
 	}
+	if d.values.secretReplicationEnabled() || d.values.trustBundleCopiesEnabled() {
+		// the secret replication and the trust bundle controller select the target namespaces, the secrets are covered by the rules above
+		role.Rules = append(role.Rules, rbacv1.PolicyRule{
+			APIGroups: []string{""},
+			Resources: []string{"namespaces"},
+			Verbs:     []string{"get", "list", "watch"},
+		})
+	}
+	if d.values.csrSignerEnabled() {
+		// the CSR signer approves and signs the certificate signing requests of its signer name only
+		role.Rules = append(role.Rules,
+			rbacv1.PolicyRule{
+				APIGroups: []string{"certificates.k8s.io"},
+				Resources: []string{"certificatesigningrequests"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			rbacv1.PolicyRule{
+				APIGroups: []string{"certificates.k8s.io"},
+				Resources: []string{"certificatesigningrequests/approval", "certificatesigningrequests/status"},
+				Verbs:     []string{"update"},
+			},
+			rbacv1.PolicyRule{
+				APIGroups:     []string{"certificates.k8s.io"},
+				Resources:     []string{"signers"},
+				ResourceNames: []string{d.values.CertConfig.CSRSigner.SignerName},
+				Verbs:         []string{"approve", "sign"},
+			},
+		)
+	}
+	if d.values.trustBundleCopiesEnabled() {
+		// the trust bundle controller maintains the copies of the trust bundle config map in the target namespaces
+		role.Rules = append(role.Rules, rbacv1.PolicyRule{
+			APIGroups: []string{""},
+			Resources: []string{"configmaps"},
+			Verbs:     []string{"get", "list", "update", "watch", "create", "delete"},
+		})
+	}
 	return role
 }
 
@@ -19,6 +19,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	"github.com/gardener/gardener/pkg/utils/test/matchers"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -1150,6 +1151,254 @@ var _ = Describe("Deployer", func() {
 			}
 			testShootManagedResource(resources, true)
 		})
+
+		It("should deploy the shoot managed resource with secret replication", func() {
+			values.CertConfig.SecretReplication = &service.SecretReplication{
+				Enabled: true,
+			}
+			resources := standardShootResources()
+			role := resources[2].(*rbacv1.ClusterRole)
+			role.Rules = append(role.Rules, rbacv1.PolicyRule{
+				APIGroups: []string{""},
+				Resources: []string{"namespaces"},
+				Verbs:     []string{"get", "list", "watch"},
+			})
+			testShootManagedResource(resources, false)
+		})
+
+		It("should deploy the shoot managed resource with trust bundle", func() {
+			Expect(c.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ref-original-ca-secret",
+					Namespace: "shoot--foo--bar",
+				},
+				Data: map[string][]byte{
+					corev1.TLSCertKey:       []byte("corporate-ca\n"),
+					corev1.TLSPrivateKeyKey: []byte("corporate-ca-key\n"),
+				},
+			})).To(Succeed())
+			values.CertConfig.Issuers = []service.IssuerConfig{
+				{
+					Name: "corporate",
+					CA:   &service.CAIssuerConfig{SecretName: "ca-secret"},
+				},
+			}
+			values.Resources = []gardencorev1beta1.NamedResourceReference{
+				{Name: "ca-secret", ResourceRef: autoscalingv1.CrossVersionObjectReference{Name: "original-ca-secret", Kind: "Secret"}},
+			}
+			values.CertConfig.TrustBundle = &service.TrustBundle{Enabled: true}
+			values.ClusterCABundle = "cluster-ca-new\ncluster-ca-old\n"
+
+			resources := append(standardShootResources(), &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot-cert-service-trust-bundle",
+					Namespace: "kube-system",
+					Labels: map[string]string{
+						"app.kubernetes.io/instance": "shoot-cert-management-shoot",
+					},
+				},
+				Data: map[string]string{
+					"ca.crt": "cert1\ncert2\ncorporate-ca\ncluster-ca-new\ncluster-ca-old\n",
+				},
+			})
+			testShootManagedResource(resources, false)
+		})
+
+		It("should deploy the shoot managed resource with permissions for the copies of the trust bundle", func() {
+			values.CertConfig.TrustBundle = &service.TrustBundle{
+				Enabled:           true,
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"trust": "true"}},
+			}
+			values.CertConfig.SecretReplication = &service.SecretReplication{Enabled: true}
+			resources := append(standardShootResources(), &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot-cert-service-trust-bundle",
+					Namespace: "kube-system",
+					Labels: map[string]string{
+						"app.kubernetes.io/instance": "shoot-cert-management-shoot",
+					},
+				},
+				Data: map[string]string{
+					"ca.crt": "cert1\ncert2\n",
+				},
+			})
+			role := resources[2].(*rbacv1.ClusterRole)
+			role.Rules = append(role.Rules,
+				rbacv1.PolicyRule{
+					APIGroups: []string{""},
+					Resources: []string{"namespaces"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+				rbacv1.PolicyRule{
+					APIGroups: []string{""},
+					Resources: []string{"configmaps"},
+					Verbs:     []string{"get", "list", "update", "watch", "create", "delete"},
+				},
+			)
+			testShootManagedResource(resources, false)
+		})
+
+		It("should deploy the shoot managed resource with permissions for the CSR signer", func() {
+			values.CertConfig.CSRSigner = &service.CSRSigner{
+				Enabled:    true,
+				SignerName: "example.com/shoot",
+			}
+			resources := standardShootResources()
+			role := resources[2].(*rbacv1.ClusterRole)
+			role.Rules = append(role.Rules,
+				rbacv1.PolicyRule{
+					APIGroups: []string{"certificates.k8s.io"},
+					Resources: []string{"certificatesigningrequests"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+				rbacv1.PolicyRule{
+					APIGroups: []string{"certificates.k8s.io"},
+					Resources: []string{"certificatesigningrequests/approval", "certificatesigningrequests/status"},
+					Verbs:     []string{"update"},
+				},
+				rbacv1.PolicyRule{
+					APIGroups:     []string{"certificates.k8s.io"},
+					Resources:     []string{"signers"},
+					ResourceNames: []string{"example.com/shoot"},
+					Verbs:         []string{"approve", "sign"},
+				},
+			)
+			testShootManagedResource(resources, false)
+		})
+
+		It("should deploy the shoot managed resource with ingress certificate", func() {
+			values.ShootDomain = "foo.example.com"
+			values.CertConfig.IngressCertificate = &service.IngressCertificate{
+				Enabled:              true,
+				AdditionalSubdomains: []string{"apps"},
+			}
+			resources := append(standardShootResources(), &certv1alpha1.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot-cert-service-ingress-wildcard",
+					Namespace: "kube-system",
+					Labels: map[string]string{
+						"app.kubernetes.io/instance": "shoot-cert-management-shoot",
+					},
+				},
+				Spec: certv1alpha1.CertificateSpec{
+					DNSNames: []string{"*.ingress.foo.example.com", "*.apps.foo.example.com"},
+					SecretRef: &corev1.SecretReference{
+						Name:      "shoot-cert-service-ingress-wildcard",
+						Namespace: "kube-system",
+					},
+				},
+			})
+			testShootManagedResource(resources, false)
+		})
+
+		It("should deploy the shoot managed resource without ingress certificate if the shoot has no domain", func() {
+			values.CertConfig.IngressCertificate = &service.IngressCertificate{Enabled: true}
+			testShootManagedResource(standardShootResources(), false)
+		})
+
+		It("should deploy the shoot managed resource with declared certificates", func() {
+			values.CertConfig.Certificates = []service.Certificate{
+				{
+					Name:       "wildcard",
+					DNSNames:   []string{"*.apps.foo.example.com"},
+					SecretName: "wildcard-tls",
+				},
+				{
+					Name:            "external",
+					DNSNames:        []string{"www.example.org", "example.org"},
+					SecretName:      "external-tls",
+					SecretNamespace: new("web"),
+					IssuerName:      new("custom"),
+					PrivateKey:      &service.CertificatePrivateKey{Algorithm: new("ECDSA"), Size: new(int32(384))},
+					Duration:        &metav1.Duration{Duration: 720 * time.Hour},
+				},
+			}
+			labels := map[string]string{"app.kubernetes.io/instance": "shoot-cert-management-shoot"}
+			resources := append(standardShootResources(),
+				&certv1alpha1.Certificate{
+					ObjectMeta: metav1.ObjectMeta{Name: "wildcard", Namespace: "default", Labels: labels},
+					Spec: certv1alpha1.CertificateSpec{
+						DNSNames:  []string{"*.apps.foo.example.com"},
+						SecretRef: &corev1.SecretReference{Name: "wildcard-tls", Namespace: "default"},
+					},
+				},
+				&certv1alpha1.Certificate{
+					ObjectMeta: metav1.ObjectMeta{Name: "external", Namespace: "web", Labels: labels},
+					Spec: certv1alpha1.CertificateSpec{
+						DNSNames:  []string{"www.example.org", "example.org"},
+						SecretRef: &corev1.SecretReference{Name: "external-tls", Namespace: "web"},
+						IssuerRef: &certv1alpha1.IssuerRef{Name: "custom"},
+						PrivateKey: &certv1alpha1.CertificatePrivateKey{
+							Algorithm: new(certv1alpha1.ECDSAKeyAlgorithm),
+							Size:      new(certv1alpha1.PrivateKeySize(384)),
+						},
+						Duration: &metav1.Duration{Duration: 720 * time.Hour},
+					},
+				},
+			)
+			testShootManagedResource(resources, false)
+		})
+
+		It("should set the preferred chain of the issuer as default for the certificates", func() {
+			values.ShootDomain = "foo.example.com"
+			values.ExtensionConfig.ACME.PreferredChain = new("ISRG Root X1")
+			values.CertConfig.IngressCertificate = &service.IngressCertificate{Enabled: true}
+			values.CertConfig.Issuers = []service.IssuerConfig{
+				{Name: "custom", Server: "https://acme.example.com/directory", Email: "bar@example.com", PreferredChain: new("Custom Root")},
+				{Name: "other", Server: "https://acme.example.com/directory", Email: "bar@example.com"},
+			}
+			values.CertConfig.Certificates = []service.Certificate{
+				{Name: "default", DNSNames: []string{"www.foo.example.com"}, SecretName: "default-tls"},
+				{Name: "custom", DNSNames: []string{"www.example.org"}, SecretName: "custom-tls", IssuerName: new("custom")},
+				{Name: "other", DNSNames: []string{"www.example.com"}, SecretName: "other-tls", IssuerName: new("other")},
+			}
+
+			deployer := NewDeployer(values)
+			Expect(deployer.createIngressCertificate()).To(ConsistOf(HaveField("Spec.PreferredChain", Equal(new("ISRG Root X1")))))
+			Expect(deployer.createCertificates()).To(ConsistOf(
+				And(HaveField("Name", "default"), HaveField("Spec.PreferredChain", Equal(new("ISRG Root X1")))),
+				And(HaveField("Name", "custom"), HaveField("Spec.PreferredChain", Equal(new("Custom Root")))),
+				And(HaveField("Name", "other"), HaveField("Spec.PreferredChain", BeNil())),
+			))
+
+			values.CertConfig.DefaultIssuer = &service.DefaultIssuer{Name: "custom"}
+			Expect(NewDeployer(values).createIngressCertificate()).To(ConsistOf(HaveField("Spec.PreferredChain", Equal(new("Custom Root")))))
+		})
+
+		It("should set the key type of the issuer as default for the private keys of the certificates", func() {
+			values.ShootDomain = "foo.example.com"
+			values.ExtensionConfig.ACME.KeyType = new("ECDSA384")
+			values.CertConfig.IngressCertificate = &service.IngressCertificate{Enabled: true}
+			values.CertConfig.Issuers = []service.IssuerConfig{
+				{Name: "custom", Server: "https://acme.example.com/directory", Email: "bar@example.com", KeyType: new("RSA3072")},
+			}
+			values.CertConfig.Certificates = []service.Certificate{
+				{Name: "default", DNSNames: []string{"www.foo.example.com"}, SecretName: "default-tls"},
+				{Name: "custom", DNSNames: []string{"www.example.org"}, SecretName: "custom-tls", IssuerName: new("custom")},
+				{Name: "explicit", DNSNames: []string{"www.example.org"}, SecretName: "explicit-tls", IssuerName: new("custom"),
+					PrivateKey: &service.CertificatePrivateKey{Algorithm: new("ECDSA")}},
+			}
+
+			ecdsa384 := &certv1alpha1.CertificatePrivateKey{Algorithm: ptr.To(certv1alpha1.ECDSAKeyAlgorithm), Size: ptr.To(certv1alpha1.PrivateKeySize(384))}
+			deployer := NewDeployer(values)
+			Expect(deployer.createIngressCertificate()).To(ConsistOf(HaveField("Spec.PrivateKey", Equal(ecdsa384))))
+			Expect(deployer.createCertificates()).To(ConsistOf(
+				And(HaveField("Name", "default"), HaveField("Spec.PrivateKey", Equal(ecdsa384))),
+				And(HaveField("Name", "custom"), HaveField("Spec.PrivateKey", Equal(&certv1alpha1.CertificatePrivateKey{
+					Algorithm: ptr.To(certv1alpha1.RSAKeyAlgorithm),
+					Size:      ptr.To(certv1alpha1.PrivateKeySize(3072)),
+				}))),
+				And(HaveField("Name", "explicit"), HaveField("Spec.PrivateKey", Equal(&certv1alpha1.CertificatePrivateKey{
+					Algorithm: ptr.To(certv1alpha1.ECDSAKeyAlgorithm),
+				}))),
+			))
+		})
+
+		It("should deploy the shoot managed resource without trust bundle if there are no CA certificates", func() {
+			values.ExtensionConfig.ACME.CACertificates = nil
+			values.CertConfig.TrustBundle = &service.TrustBundle{Enabled: true}
+			testShootManagedResource(standardShootResources(), false)
+		})
 	})
 
 	Describe("DeploySeedManagedResource", func() {
@@ -1176,6 +1425,48 @@ var _ = Describe("Deployer", func() {
 			})
 		})
 
+		It("should deploy it with kube-apiserver SNI certificate", func() {
+			values.ShootDomain = "foo.example.com"
+			values.CertConfig.KubeAPIServerSNI = &service.KubeAPIServerSNI{
+				Enabled:           true,
+				AdditionalDomains: []string{"k8s.foo.example.com"},
+			}
+			resources := standardSeedResources()
+			for _, obj := range resources {
+				if role, ok := obj.(*rbacv1.Role); ok {
+					role.Rules = append(role.Rules, rbacv1.PolicyRule{
+						APIGroups: []string{"cert.gardener.cloud"},
+						Resources: []string{"certificates", "certificates/status"},
+						Verbs:     []string{"get", "list", "update", "patch", "watch"},
+					})
+				}
+			}
+			resources = append(resources, &certv1alpha1.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot-cert-service-kube-apiserver-sni",
+					Namespace: "shoot--foo--bar",
+					Labels: map[string]string{
+						"app.kubernetes.io/name":     "shoot-cert-management-seed",
+						"app.kubernetes.io/instance": "shoot-cert-management-seed",
+					},
+				},
+				Spec: certv1alpha1.CertificateSpec{
+					DNSNames: []string{"api.foo.example.com", "k8s.foo.example.com"},
+					SecretRef: &corev1.SecretReference{
+						Name:      "kube-apiserver-sni-shoot-cert-service",
+						Namespace: "shoot--foo--bar",
+					},
+				},
+			})
+			testSeedManagedResource(resources, nil)
+		})
+
+		It("should not deploy the kube-apiserver SNI certificate in the shoot cluster", func() {
+			values.ShootDomain = "foo.example.com"
+			values.CertConfig.KubeAPIServerSNI = &service.KubeAPIServerSNI{Enabled: true}
+			testShootManagedResource(standardShootResources(), false)
+		})
+
 		It("should deploy it with DNS challenges on shoot", func() {
 			values.CertConfig.DNSChallengeOnShoot = &service.DNSChallengeOnShoot{
 				Enabled:   true,
@@ -1218,6 +1509,17 @@ var _ = Describe("Deployer", func() {
 			})
 		})
 
+		It("should deploy it without issuers on shoot if the compliance profile restricts the issuer servers", func() {
+			values.CertConfig.ShootIssuers = &service.ShootIssuers{
+				Enabled: true,
+			}
+			values.ComplianceProfile = &config.ComplianceProfile{
+				Name:                 "regulated",
+				AllowedIssuerServers: []string{"https://acme-v02.api.letsencrypt.org/directory"},
+			}
+			testSeedManagedResource(standardSeedResources(), nil)
+		})
+
 		It("should deploy it resource without alerting", func() {
 			values.CertConfig.Alerting = &service.Alerting{CertExpirationAlertDays: new(0)}
 			resources := excludeResourcesByType(standardSeedResources(), &monitoringv1.PrometheusRule{})
@@ -1246,6 +1548,30 @@ var _ = Describe("Deployer", func() {
 			})
 		})
 
+		It("should deploy it resource with the private key defaults of the shoot", func() {
+			values.ExtensionConfig.PrivateKeyDefaults = &config.PrivateKeyDefaults{
+				SizeRSA: new(4096),
+			}
+			values.CertConfig.PrivateKeyDefaults = &service.PrivateKeyDefaults{
+				Algorithm: new("ECDSA"),
+				SizeECDSA: new(int32(256)),
+			}
+			testSeedManagedResource(standardSeedResources(), func(deployment *appsv1.Deployment) {
+				deployment.Spec.Template.Spec.Containers[0].Args = removeArgs(deployment.Spec.Template.Spec.Containers[0].Args,
+					"--default-private-key-algorithm=RSA",
+					"--default-rsa-private-key-size=3072",
+					"--default-ecdsa-private-key-size=384",
+				)
+				deployment.Spec.Template.Spec.Containers[0].Args = insertArgsAfter(
+					"<end>",
+					deployment.Spec.Template.Spec.Containers[0].Args,
+					"--default-private-key-algorithm=ECDSA",
+					"--default-rsa-private-key-size=4096",
+					"--default-ecdsa-private-key-size=256",
+				)
+			})
+		})
+
 		It("should deploy it with propagation timeout", func() {
 			values.ExtensionConfig.ACME.PropagationTimeout = &metav1.Duration{Duration: 5 * time.Minute}
 			testSeedManagedResource(standardSeedResources(), func(deployment *appsv1.Deployment) {
@@ -1257,6 +1583,24 @@ var _ = Describe("Deployer", func() {
 			})
 		})
 
+		It("should deploy it with the ACME settings of the shoot", func() {
+			values.ExtensionConfig.ACME.PropagationTimeout = &metav1.Duration{Duration: 5 * time.Minute}
+			values.CertConfig.ACME = &service.ACMESettings{
+				PropagationTimeout:       &metav1.Duration{Duration: 10 * time.Minute},
+				PrecheckAdditionalWait:   &metav1.Duration{Duration: 30 * time.Second},
+				DeactivateAuthorizations: new(false),
+			}
+			testSeedManagedResource(standardSeedResources(), func(deployment *appsv1.Deployment) {
+				deployment.Spec.Template.Spec.Containers[0].Args = removeArgs(deployment.Spec.Template.Spec.Containers[0].Args, "--acme-deactivate-authorizations")
+				deployment.Spec.Template.Spec.Containers[0].Args = insertArgsAfter(
+					"--issuer.default.pool.size=",
+					deployment.Spec.Template.Spec.Containers[0].Args,
+					"--propagation-timeout=10m0s",
+					"--precheck-additional-wait=30s",
+				)
+			})
+		})
+
 		It("should deploy it with special DNS class for next generation dns-controller-manager", func() {
 			values.NextGenDNSShootService = true
 			testSeedManagedResource(standardSeedResources(), func(deployment *appsv1.Deployment) {
@@ -1379,6 +1723,265 @@ var _ = Describe("Deployer", func() {
 			})
 		})
 
+		It("should deploy catalog issuers with the referenced credentials of the operator", func() {
+			values.ExtensionConfig.IssuerCatalog = []config.CatalogIssuer{
+				{
+					Name: "zerossl-eab",
+					ACME: &config.CatalogACME{
+						Email:               "operator@example.com",
+						Server:              "https://acme.zerossl.com/v2/DV90",
+						PrivateKeySecretRef: &corev1.SecretReference{Name: "zerossl-account", Namespace: "garden"},
+						ExternalAccountBinding: &config.ACMEExternalAccountBinding{
+							KeyID:        "key-id",
+							KeySecretRef: &corev1.SecretReference{Name: "zerossl-eab", Namespace: "garden"},
+						},
+					},
+				},
+				{
+					Name: "corp-ca",
+					CA: &config.CA{
+						SecretRef: &corev1.SecretReference{Name: "corp-ca", Namespace: "garden"},
+					},
+				},
+			}
+			values.CertConfig.Issuers = []service.IssuerConfig{
+				{Name: "zerossl", CatalogIssuer: new("zerossl-eab"), RequestsPerDayQuota: new(10)},
+				{Name: "corp", CatalogIssuer: new("corp-ca")},
+			}
+
+			objects, issuers, err := NewDeployer(values).createIssuers()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).NotTo(ContainElement(And(
+				BeAssignableToTypeOf(&corev1.Secret{}),
+				HaveField("ObjectMeta.Name", Or(HavePrefix("extension-shoot-cert-service-issuer-zerossl"), HavePrefix("extension-shoot-cert-service-issuer-corp"))),
+			)))
+			Expect(objects).To(ContainElements(
+				&certv1alpha1.Issuer{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "zerossl",
+						Namespace: "shoot--foo--bar",
+						Labels:    map[string]string{LabelCatalogIssuer: "zerossl-eab"},
+					},
+					Spec: certv1alpha1.IssuerSpec{
+						ACME: &certv1alpha1.ACMESpec{
+							Server: "https://acme.zerossl.com/v2/DV90",
+							Email:  "operator@example.com",
+							PrivateKeySecretRef: &corev1.SecretReference{
+								Name:      "extension-shoot-cert-service-issuer-zerossl-copy",
+								Namespace: "shoot--foo--bar",
+							},
+							ExternalAccountBinding: &certv1alpha1.ACMEExternalAccountBinding{
+								KeyID: "key-id",
+								KeySecretRef: &corev1.SecretReference{
+									Name:      "extension-shoot-cert-service-issuer-zerossl-eab-copy",
+									Namespace: "shoot--foo--bar",
+								},
+							},
+						},
+						RequestsPerDayQuota: new(10),
+					},
+				},
+				&certv1alpha1.Issuer{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "corp",
+						Namespace: "shoot--foo--bar",
+						Labels:    map[string]string{LabelCatalogIssuer: "corp-ca"},
+					},
+					Spec: certv1alpha1.IssuerSpec{
+						CA: &certv1alpha1.CASpec{
+							PrivateKeySecretRef: &corev1.SecretReference{
+								Name:      "extension-shoot-cert-service-issuer-corp-ca-copy",
+								Namespace: "shoot--foo--bar",
+							},
+						},
+					},
+				},
+			))
+			Expect(issuers).To(HaveLen(3))
+			Expect(issuers[1].ACME.SourceSecret).To(Equal(&client.ObjectKey{Namespace: "garden", Name: "zerossl-account"}))
+			Expect(issuers[1].ACME.ExternalAccountBinding.SourceSecret).To(Equal(&client.ObjectKey{Namespace: "garden", Name: "zerossl-eab"}))
+			Expect(issuers[2].CA.SourceSecret).To(Equal(&client.ObjectKey{Namespace: "garden", Name: "corp-ca"}))
+		})
+
+		It("should restrict catalog issuers to the domains of the operator", func() {
+			values.ExtensionConfig.IssuerCatalog = []config.CatalogIssuer{
+				{
+					Name: "team-acme",
+					ACME: &config.CatalogACME{
+						Email:   "operator@example.com",
+						Server:  "https://acme.example.com/directory",
+						Domains: &config.DNSSelection{Include: []string{"team-a.example.com"}, Exclude: []string{"internal.team-a.example.com"}},
+					},
+				},
+			}
+			values.CertConfig.Issuers = []service.IssuerConfig{
+				{Name: "team", CatalogIssuer: new("team-acme")},
+				{Name: "team-app", CatalogIssuer: new("team-acme"), Domains: &service.DNSSelection{
+					Include: []string{"app.team-a.example.com"},
+					Exclude: []string{"test.app.team-a.example.com"},
+				}},
+			}
+
+			_, issuers, err := NewDeployer(values).createIssuers()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issuers).To(HaveLen(3))
+			Expect(issuers[1].ACME.Domains).To(Equal(&Domains{
+				Include: []string{"team-a.example.com"},
+				Exclude: []string{"internal.team-a.example.com"},
+			}))
+			Expect(issuers[2].ACME.Domains).To(Equal(&Domains{
+				Include: []string{"app.team-a.example.com"},
+				Exclude: []string{"internal.team-a.example.com", "test.app.team-a.example.com"},
+			}))
+		})
+
+		It("should configure the proxy of the cert-controller-manager", func() {
+			values.ExtensionConfig.ACME.CACertificates = nil
+			values.ExtensionConfig.Proxy = &config.Proxy{
+				HTTPSProxy: new("http://proxy.example.com:3128"),
+				NoProxy:    new("10.0.0.0/8"),
+			}
+			values.SeedKubeAPIServerHost = "100.64.0.1"
+
+			Expect(NewDeployer(values).env(nil)).To(Equal([]corev1.EnvVar{
+				{Name: "HTTPS_PROXY", Value: "http://proxy.example.com:3128"},
+				{Name: "NO_PROXY", Value: "localhost,127.0.0.1,kube-apiserver,.svc,.cluster.local,100.64.0.1,10.0.0.0/8"},
+			}))
+		})
+
+		It("should deploy the fallback issuers and use the active default issuer", func() {
+			values.ExtensionConfig.DefaultIssuerFallback = &config.DefaultIssuerFallback{
+				Issuers: []config.CatalogIssuer{
+					{
+						Name: "zerossl",
+						ACME: &config.CatalogACME{
+							Email:  "operator@example.com",
+							Server: "https://acme.zerossl.com/v2/DV90",
+							ExternalAccountBinding: &config.ACMEExternalAccountBinding{
+								KeyID:        "key-id",
+								KeySecretRef: &corev1.SecretReference{Name: "zerossl-eab", Namespace: "garden"},
+							},
+						},
+					},
+				},
+			}
+			values.ActiveDefaultIssuer = "zerossl"
+
+			deployer := NewDeployer(values)
+			Expect(deployer.args()).To(ContainElement("--issuer.default-issuer=zerossl"))
+
+			objects, _, err := deployer.createIssuers()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(ContainElements(
+				&certv1alpha1.Issuer{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "zerossl",
+						Namespace: "shoot--foo--bar",
+						Labels:    map[string]string{LabelFallbackIssuer: "true"},
+					},
+					Spec: certv1alpha1.IssuerSpec{
+						ACME: &certv1alpha1.ACMESpec{
+							Server:           "https://acme.zerossl.com/v2/DV90",
+							Email:            "operator@example.com",
+							AutoRegistration: true,
+							PrivateKeySecretRef: &corev1.SecretReference{
+								Name:      "extension-shoot-cert-service-issuer-zerossl",
+								Namespace: "shoot--foo--bar",
+							},
+							ExternalAccountBinding: &certv1alpha1.ACMEExternalAccountBinding{
+								KeyID: "key-id",
+								KeySecretRef: &corev1.SecretReference{
+									Name:      "extension-shoot-cert-service-issuer-zerossl-eab-copy",
+									Namespace: "shoot--foo--bar",
+								},
+							},
+						},
+					},
+				},
+			))
+
+			values.CertConfig.DefaultIssuer = &service.DefaultIssuer{DisableGardenIssuer: true}
+			deployer = NewDeployer(values)
+			issuers, err := deployer.collectIssuers()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issuers).NotTo(ContainElement(HaveField("Name", "zerossl")))
+		})
+
+		It("should restrict the fallback issuers to the shoot domains", func() {
+			values.ExtensionConfig.RestrictIssuer = new(true)
+			values.ExtensionConfig.ACME.Domains = &config.DNSSelection{
+				Include: []string{"example.com"},
+				Exclude: []string{"internal.example.com"},
+			}
+			values.RestrictedDomains = "foo.example.com,bar.example.org"
+			values.ExtensionConfig.DefaultIssuerFallback = &config.DefaultIssuerFallback{
+				Issuers: []config.CatalogIssuer{
+					{
+						Name: "zerossl",
+						ACME: &config.CatalogACME{
+							Email:  "operator@example.com",
+							Server: "https://acme.zerossl.com/v2/DV90",
+						},
+					},
+				},
+			}
+			values.ActiveDefaultIssuer = "zerossl"
+
+			deployer := NewDeployer(values)
+			Expect(deployer.args()).To(ContainElements(
+				"--issuer.default-issuer=zerossl",
+				"--issuer.default-issuer-domain-ranges=foo.example.com,bar.example.org",
+			))
+
+			issuers, err := deployer.collectIssuers()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issuers).To(ContainElements(
+				And(HaveField("Name", "garden"), HaveField("ACME.Domains", Equal(&Domains{
+					Include: []string{"example.com"},
+					Exclude: []string{"internal.example.com"},
+				}))),
+				And(HaveField("Name", "zerossl"), HaveField("ACME.Domains", Equal(&Domains{
+					Include: []string{"foo.example.com", "bar.example.org"},
+				}))),
+			))
+		})
+
+		It("should use the selected default issuer and skip the disabled garden issuer", func() {
+			prepareValuesWithIssuers()
+			values.ExtensionConfig.RestrictIssuer = new(true)
+			values.RestrictedDomains = "sub1.example.com"
+			values.CertConfig.DefaultIssuer = &service.DefaultIssuer{Name: "bar2", DisableGardenIssuer: true}
+
+			deployer := NewDeployer(values)
+			Expect(deployer.args()).To(ContainElement("--issuer.default-issuer=bar2"))
+			Expect(deployer.args()).NotTo(ContainElement(HavePrefix("--issuer.default-issuer-domain-ranges=")))
+
+			issuers, err := deployer.collectIssuers()
+			Expect(err).NotTo(HaveOccurred())
+			var names []string
+			for _, issuer := range issuers {
+				names = append(names, issuer.Name)
+			}
+			Expect(names).To(ConsistOf("bar", "bar2"))
+		})
+
+		It("should skip the restricted garden issuer for shoots without domain", func() {
+			prepareValuesWithIssuers()
+			values.ExtensionConfig.RestrictIssuer = new(true)
+			values.MissingShootDomain = true
+
+			deployer := NewDeployer(values)
+			Expect(deployer.args()).NotTo(ContainElement(HavePrefix("--issuer.default-issuer-domain-ranges=")))
+
+			issuers, err := deployer.collectIssuers()
+			Expect(err).NotTo(HaveOccurred())
+			var names []string
+			for _, issuer := range issuers {
+				names = append(names, issuer.Name)
+			}
+			Expect(names).To(ConsistOf("bar", "bar2"))
+		})
+
 		It("should have validation errors for invalid issuer secrets", func() {
 			barSecret := &corev1.Secret{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1411,6 +2014,274 @@ var _ = Describe("Deployer", func() {
 			Expect(msg).To(HavePrefix("failed to validate issuer secrets: failed to validate ACME private key secret for issuer bar: invalid secret data keys: `invalid-key`"))
 			Expect(msg).To(ContainSubstring("failed to validate EAB key secret for issuer bar: key hmacKey not found in EAB secret shoot--foo--bar/ref-original-eab-secret"))
 		})
+
+		It("should copy the referenced private key secret instead of adding it to the managed resource", func() {
+			values.ExtensionNamespace = "extension-shoot-cert-service-abc"
+			key, err := (&secretsutils.CertificateSecretConfig{Name: "acme", CommonName: "acme", CertType: secretsutils.CACert}).Generate()
+			Expect(err).NotTo(HaveOccurred())
+			source := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "acme-account", Namespace: "extension-shoot-cert-service-abc"},
+				Data:       map[string][]byte{"privateKey": key.(*secretsutils.Certificate).PrivateKeyPEM},
+				Type:       corev1.SecretTypeOpaque,
+			}
+			Expect(c.Create(ctx, source)).To(Succeed())
+			values.ExtensionConfig.ACME.PrivateKey = nil
+			values.ExtensionConfig.ACME.PrivateKeySecretRef = &corev1.SecretReference{Name: "acme-account"}
+
+			deployer := NewDeployer(values)
+			Expect(deployer.DeploySeedManagedResource(ctx, c)).To(Succeed())
+			secretCopy := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: "shoot--foo--bar", Name: "extension-shoot-cert-service-issuer-garden-copy"}, secretCopy)).To(Succeed())
+			Expect(secretCopy.Labels).To(HaveKeyWithValue(LabelIssuerSecretCopy, "true"))
+			Expect(secretCopy.Annotations).To(HaveKeyWithValue(AnnotationCopiedFrom, "extension-shoot-cert-service-abc/acme-account"))
+			Expect(secretCopy.Data).To(Equal(source.Data))
+
+			objects, _, err := deployer.createIssuers()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(ConsistOf(&certv1alpha1.Issuer{
+				ObjectMeta: metav1.ObjectMeta{Name: "garden", Namespace: "shoot--foo--bar"},
+				Spec: certv1alpha1.IssuerSpec{
+					ACME: &certv1alpha1.ACMESpec{
+						Server: "https://acme-v02.api.letsencrypt.org/directory",
+						Email:  "foo@example.com",
+						PrivateKeySecretRef: &corev1.SecretReference{
+							Name:      "extension-shoot-cert-service-issuer-garden-copy",
+							Namespace: "shoot--foo--bar",
+						},
+					},
+				},
+			}), "the key material must not be part of the managed resource")
+
+			By("rotating the private key")
+			rotated, err := (&secretsutils.CertificateSecretConfig{Name: "acme", CommonName: "acme", CertType: secretsutils.CACert}).Generate()
+			Expect(err).NotTo(HaveOccurred())
+			source.Data["privateKey"] = rotated.(*secretsutils.Certificate).PrivateKeyPEM
+			Expect(c.Update(ctx, source)).To(Succeed())
+			Expect(deployer.DeploySeedManagedResource(ctx, c)).To(Succeed())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secretCopy), secretCopy)).To(Succeed())
+			Expect(secretCopy.Data).To(Equal(source.Data))
+
+			By("switching to the inline private key")
+			values.ExtensionConfig.ACME.PrivateKey = new("<private-key>")
+			values.ExtensionConfig.ACME.PrivateKeySecretRef = nil
+			Expect(NewDeployer(values).DeploySeedManagedResource(ctx, c)).To(Succeed())
+			Expect(errors.IsNotFound(c.Get(ctx, client.ObjectKeyFromObject(secretCopy), secretCopy))).To(BeTrue())
+		})
+
+		It("should deploy the default issuer with external account binding and domains", func() {
+			eabSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "acme-eab", Namespace: "garden"},
+				Data:       map[string][]byte{"hmacKey": []byte("bWFjLWtleQ")},
+				Type:       corev1.SecretTypeOpaque,
+			}
+			Expect(c.Create(ctx, eabSecret)).To(Succeed())
+			values.ExtensionConfig.ACME.ExternalAccountBinding = &config.ACMEExternalAccountBinding{
+				KeyID:        "key-id",
+				KeySecretRef: &corev1.SecretReference{Name: "acme-eab", Namespace: "garden"},
+			}
+			values.ExtensionConfig.ACME.Domains = &config.DNSSelection{Include: []string{"example.com"}, Exclude: []string{"internal.example.com"}}
+
+			deployer := NewDeployer(values)
+			Expect(deployer.DeploySeedManagedResource(ctx, c)).To(Succeed())
+			secretCopy := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: "shoot--foo--bar", Name: "extension-shoot-cert-service-issuer-garden-eab-copy"}, secretCopy)).To(Succeed())
+			Expect(secretCopy.Data).To(Equal(eabSecret.Data))
+
+			objects, _, err := deployer.createIssuers()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(ContainElement(&certv1alpha1.Issuer{
+				ObjectMeta: metav1.ObjectMeta{Name: "garden", Namespace: "shoot--foo--bar"},
+				Spec: certv1alpha1.IssuerSpec{
+					ACME: &certv1alpha1.ACMESpec{
+						Server: "https://acme-v02.api.letsencrypt.org/directory",
+						Email:  "foo@example.com",
+						PrivateKeySecretRef: &corev1.SecretReference{
+							Name:      "extension-shoot-cert-service-issuer-garden",
+							Namespace: "shoot--foo--bar",
+						},
+						ExternalAccountBinding: &certv1alpha1.ACMEExternalAccountBinding{
+							KeyID:        "key-id",
+							KeySecretRef: &corev1.SecretReference{Name: "extension-shoot-cert-service-issuer-garden-eab-copy", Namespace: "shoot--foo--bar"},
+						},
+						Domains: &certv1alpha1.DNSSelection{Include: []string{"example.com"}, Exclude: []string{"internal.example.com"}},
+					},
+				},
+			}))
+
+			By("validating the external account binding secret")
+			delete(eabSecret.Data, "hmacKey")
+			Expect(c.Update(ctx, eabSecret)).To(Succeed())
+			Expect(deployer.DeploySeedManagedResource(ctx, c)).To(MatchError(ContainSubstring("failed to validate EAB key secret for issuer garden")))
+		})
+
+		It("should use a referenced CA secret in the namespace of the deployment directly", func() {
+			caCert, err := (&secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert}).Generate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "garden-ca", Namespace: "shoot--foo--bar"},
+				Data: map[string][]byte{
+					corev1.TLSCertKey:       caCert.(*secretsutils.Certificate).CertificatePEM,
+					corev1.TLSPrivateKeyKey: caCert.(*secretsutils.Certificate).PrivateKeyPEM,
+				},
+				Type: corev1.SecretTypeTLS,
+			})).To(Succeed())
+			values.ExtensionConfig.ACME = nil
+			values.ExtensionConfig.CA = &config.CA{SecretRef: &corev1.SecretReference{Name: "garden-ca", Namespace: "shoot--foo--bar"}}
+			values.CertConfig.TrustBundle = &service.TrustBundle{Enabled: true}
+
+			deployer := NewDeployer(values)
+			objects, _, err := deployer.createIssuers()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(ConsistOf(&certv1alpha1.Issuer{
+				ObjectMeta: metav1.ObjectMeta{Name: "garden", Namespace: "shoot--foo--bar"},
+				Spec: certv1alpha1.IssuerSpec{
+					CA: &certv1alpha1.CASpec{
+						PrivateKeySecretRef: &corev1.SecretReference{Name: "garden-ca", Namespace: "shoot--foo--bar"},
+					},
+				},
+			}))
+			Expect(deployer.collectTrustBundle(ctx, c)).To(Equal(string(caCert.(*secretsutils.Certificate).CertificatePEM)))
+		})
+
+		It("should deploy it with additional CA issuer", func() {
+			caCert, err := (&secretsutils.CertificateSecretConfig{
+				Name:       "corporate-ca",
+				CommonName: "corporate-ca",
+				CertType:   secretsutils.CACert,
+			}).Generate()
+			Expect(err).NotTo(HaveOccurred())
+			caSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ref-original-ca-secret",
+					Namespace: "shoot--foo--bar",
+				},
+				Data: map[string][]byte{
+					corev1.TLSCertKey:       caCert.(*secretsutils.Certificate).CertificatePEM,
+					corev1.TLSPrivateKeyKey: caCert.(*secretsutils.Certificate).PrivateKeyPEM,
+				},
+				Type: corev1.SecretTypeTLS,
+			}
+			Expect(c.Create(ctx, caSecret)).To(Succeed())
+
+			values.CertConfig.Issuers = []service.IssuerConfig{
+				{
+					Name:                "corporate",
+					RequestsPerDayQuota: new(100),
+					CA: &service.CAIssuerConfig{
+						SecretName: "ca-secret",
+					},
+				},
+			}
+			values.Resources = []gardencorev1beta1.NamedResourceReference{
+				{Name: "ca-secret", ResourceRef: autoscalingv1.CrossVersionObjectReference{Name: "original-ca-secret", Kind: "Secret"}},
+			}
+			resources := append(standardSeedResources(),
+				&certv1alpha1.Issuer{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "corporate",
+						Namespace: "shoot--foo--bar",
+					},
+					Spec: certv1alpha1.IssuerSpec{
+						CA: &certv1alpha1.CASpec{
+							PrivateKeySecretRef: &corev1.SecretReference{
+								Name:      "ref-original-ca-secret",
+								Namespace: "shoot--foo--bar",
+							},
+						},
+						RequestsPerDayQuota: new(100),
+					},
+				},
+			)
+			testSeedManagedResource(resources, func(deployment *appsv1.Deployment) {
+				deployment.Spec.Template.Annotations = map[string]string{"checksum/issuers": "096b302d539f5d3247056a7f271cfca3ffa112baa8a4ee720fcf7b50e2bd0677"}
+			})
+		})
+
+		It("should mount the CA certificates of an issuer alongside the global CA certificates", func() {
+			caCert, err := (&secretsutils.CertificateSecretConfig{Name: "internal-ca", CommonName: "internal-ca", CertType: secretsutils.CACert}).Generate()
+			Expect(err).NotTo(HaveOccurred())
+			caConfigMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "ref-internal-ca", Namespace: "shoot--foo--bar"},
+				Data:       map[string]string{"ca.crt": string(caCert.(*secretsutils.Certificate).CertificatePEM)},
+			}
+			Expect(c.Create(ctx, caConfigMap)).To(Succeed())
+
+			values.CertConfig.Issuers = []service.IssuerConfig{
+				{
+					Name:                       "internal",
+					Server:                     "https://acme.internal.example.com/directory",
+					Email:                      "john@example.com",
+					CACertificatesResourceName: new("internal-ca"),
+				},
+			}
+			values.Resources = []gardencorev1beta1.NamedResourceReference{
+				{Name: "internal-ca", ResourceRef: autoscalingv1.CrossVersionObjectReference{Name: "internal-ca", Kind: "ConfigMap"}},
+			}
+
+			deployer := NewDeployer(values)
+			Expect(deployer.DeploySeedManagedResource(ctx, c)).To(Succeed())
+			deployment, err := deployer.createDeployment()
+			Expect(err).NotTo(HaveOccurred())
+			container := deployment.Spec.Template.Spec.Containers[0]
+			Expect(container.Env).To(ContainElement(corev1.EnvVar{
+				Name:  "LEGO_CA_CERTIFICATES",
+				Value: "/var/run/cert-manager/certs/certs.pem:/var/run/cert-manager/issuer-certs/internal.pem",
+			}))
+			Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      "issuer-ca-certificates",
+				MountPath: "/var/run/cert-manager/issuer-certs",
+				ReadOnly:  true,
+			}))
+			Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "issuer-ca-certificates",
+				VolumeSource: corev1.VolumeSource{
+					Projected: &corev1.ProjectedVolumeSource{
+						DefaultMode: ptr.To[int32](420),
+						Sources: []corev1.VolumeProjection{{ConfigMap: &corev1.ConfigMapProjection{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "ref-internal-ca"},
+							Items:                []corev1.KeyToPath{{Key: "ca.crt", Path: "internal.pem"}},
+						}}},
+					},
+				},
+			}))
+
+			By("validating the CA certificates")
+			caConfigMap.Data["ca.crt"] = "invalid"
+			Expect(c.Update(ctx, caConfigMap)).To(Succeed())
+			err = deployer.DeploySeedManagedResource(ctx, c)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("failed to validate issuer secrets: failed to validate CA certificates for issuer internal:"))
+		})
+
+		It("should have validation errors for invalid CA issuer secret", func() {
+			caSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ref-original-ca-secret",
+					Namespace: "shoot--foo--bar",
+				},
+				Data: map[string][]byte{
+					corev1.TLSCertKey: []byte("invalid"),
+				},
+			}
+			Expect(c.Create(ctx, caSecret)).To(Succeed())
+
+			values.CertConfig.Issuers = []service.IssuerConfig{
+				{
+					Name: "corporate",
+					CA: &service.CAIssuerConfig{
+						SecretName: "ca-secret",
+					},
+				},
+			}
+			values.Resources = []gardencorev1beta1.NamedResourceReference{
+				{Name: "ca-secret", ResourceRef: autoscalingv1.CrossVersionObjectReference{Name: "original-ca-secret", Kind: "Secret"}},
+			}
+
+			deployer := NewDeployer(values)
+			err := deployer.DeploySeedManagedResource(ctx, c)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("failed to validate issuer secrets: failed to validate CA secret for issuer corporate:"))
+		})
 	})
 
 	Describe("DeployGardenOrSeedManagedResource", func() {
@@ -1481,7 +2352,7 @@ var _ = Describe("Deployer", func() {
 			)
 			testInternalManagedResource(resources, true, func(deployment *appsv1.Deployment) {
 				deployment.Spec.Template.Annotations = map[string]string{
-					"checksum/issuers": "4eb1941a6e4f4d326b96278513c50d462df6c24d9566c0f126d34f40eeb6a506",
+					"checksum/issuers": "1683a73cb8d8c9abb63e69ff3167a01bb9f9f496470a9ced11552f09ac5430bc",
 				}
 				deployment.Spec.Template.Spec.Containers[0].Args = removeArgs(deployment.Spec.Template.Spec.Containers[0].Args, "--acme-deactivate-authorizations")
 			})
@@ -1527,6 +2398,31 @@ var _ = Describe("Deployer", func() {
 			testInternalManagedResource(resources, false, nil)
 		})
 	})
+
+	DescribeTable("#ShootIssuersEnabled",
+		func(operator *config.ShootIssuers, shoot *service.ShootIssuers, expected bool) {
+			Expect(ShootIssuersEnabled(config.Configuration{ShootIssuers: operator}, service.CertConfig{ShootIssuers: shoot}, nil)).To(Equal(expected))
+		},
+		Entry("disabled without any setting", nil, nil, false),
+		Entry("shoot setting without operator setting", nil, &service.ShootIssuers{Enabled: true}, true),
+		Entry("operator default", &config.ShootIssuers{Enabled: true, Policy: config.ShootIssuersPolicyDefault}, nil, true),
+		Entry("operator default overwritten by shoot", &config.ShootIssuers{Enabled: true, Policy: config.ShootIssuersPolicyDefault}, &service.ShootIssuers{Enabled: false}, false),
+		Entry("operator default with empty policy", &config.ShootIssuers{Enabled: true}, nil, true),
+		Entry("forbidden by operator", &config.ShootIssuers{Policy: config.ShootIssuersPolicyForbid}, &service.ShootIssuers{Enabled: true}, false),
+		Entry("forced by operator", &config.ShootIssuers{Policy: config.ShootIssuersPolicyForce}, &service.ShootIssuers{Enabled: false}, true),
+		Entry("forced by operator without shoot setting", &config.ShootIssuers{Policy: config.ShootIssuersPolicyForce}, nil, true),
+	)
+
+	It("should disable issuers on the shoot cluster if the custom issuer policy restricts the servers", func() {
+		extensionConfig := config.Configuration{
+			ShootIssuers:       &config.ShootIssuers{Enabled: true},
+			CustomIssuerPolicy: &config.CustomIssuerPolicy{AllowedServers: []string{"https://acme.example.com/directory"}},
+		}
+		Expect(ShootIssuersEnabled(extensionConfig, service.CertConfig{ShootIssuers: &service.ShootIssuers{Enabled: true}}, nil)).To(BeFalse())
+
+		extensionConfig.CustomIssuerPolicy = &config.CustomIssuerPolicy{MaxIssuers: new(1)}
+		Expect(ShootIssuersEnabled(extensionConfig, service.CertConfig{ShootIssuers: &service.ShootIssuers{Enabled: true}}, nil)).To(BeTrue())
+	})
 })
 
 func completeCRDs(objects []client.Object, keepObject bool) (int, error) {
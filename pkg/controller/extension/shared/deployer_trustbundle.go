@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/v1alpha1"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/validation"
+)
+
+// createTrustBundleConfigMap creates the config map containing the CA bundle of the issuers in the `kube-system`
+// namespace of the shoot cluster. The copies in the additionally selected namespaces are not part of the managed
+// resource, as these namespaces may be deleted anytime. They are maintained by the trust bundle controller.
+func (d *Deployer) createTrustBundleConfigMap(ctx context.Context, c client.Client) (client.Object, error) {
+	if !d.values.trustBundleEnabled() {
+		return nil, nil
+	}
+
+	bundle, err := d.collectTrustBundle(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect trust bundle: %w", err)
+	}
+	if bundle == "" {
+		return nil, nil
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      v1alpha1.TrustBundleConfigMapName,
+			Namespace: metav1.NamespaceSystem,
+			Labels:    d.getShootLabels(),
+		},
+		Data: map[string]string{
+			v1alpha1.TrustBundleDataKey: bundle,
+		},
+	}, nil
+}
+
+// collectTrustBundle collects the CA certificates of the default issuer and its fallback issuers, the custom and catalog CA issuers
+// and the cluster CA issuer.
+func (d *Deployer) collectTrustBundle(ctx context.Context, c client.Client) (string, error) {
+	var certs []string
+
+	if !d.values.gardenIssuerDisabled() {
+		if ca := d.values.ExtensionConfig.CA; ca != nil {
+			cert, err := d.caCertificate(ctx, c, ca)
+			if err != nil {
+				return "", err
+			}
+			certs = append(certs, cert)
+		}
+		if caCertificates := d.values.caCertificates(); caCertificates != "" {
+			certs = append(certs, caCertificates)
+		}
+	}
+	if d.values.FallbackIssuersEnabled() {
+		for _, fallback := range d.values.ExtensionConfig.DefaultIssuerFallback.Issuers {
+			if fallback.CA != nil {
+				cert, err := d.caCertificate(ctx, c, fallback.CA)
+				if err != nil {
+					return "", err
+				}
+				certs = append(certs, cert)
+			}
+		}
+	}
+	for _, issuer := range d.values.CertConfig.Issuers {
+		if issuer.CatalogIssuer != nil {
+			if catalogIssuer := validation.CatalogIssuer(&d.values.ExtensionConfig, *issuer.CatalogIssuer); catalogIssuer != nil && catalogIssuer.CA != nil {
+				cert, err := d.caCertificate(ctx, c, catalogIssuer.CA)
+				if err != nil {
+					return "", err
+				}
+				certs = append(certs, cert)
+			}
+			continue
+		}
+		if issuer.CA == nil {
+			continue
+		}
+		secretName, err := d.lookupReferencedSecret(issuer.CA.SecretName)
+		if err != nil {
+			return "", fmt.Errorf("failed to lookup referenced CA secret for issuer %s: %w", issuer.Name, err)
+		}
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: d.values.Namespace, Name: secretName}, secret); err != nil {
+			return "", fmt.Errorf("failed to read CA secret for issuer %s: %w", issuer.Name, err)
+		}
+		certs = append(certs, string(secret.Data[corev1.TLSCertKey]))
+	}
+	if d.values.ClusterCABundle != "" {
+		certs = append(certs, d.values.ClusterCABundle)
+	}
+
+	var bundle strings.Builder
+	for _, cert := range certs {
+		if cert = strings.TrimSpace(cert); cert != "" {
+			bundle.WriteString(cert)
+			bundle.WriteString("\n")
+		}
+	}
+	return bundle.String(), nil
+}
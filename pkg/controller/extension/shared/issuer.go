@@ -4,9 +4,15 @@
 
 package shared
 
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
 // Issuer is the configuration model for an ACME or CA issuer.
 type Issuer struct {
 	Name                string
+	CatalogIssuer       string `json:",omitempty"`
+	FallbackIssuer      bool   `json:",omitempty"`
 	ACME                *ACME
 	CA                  *CA
 	RequestsPerDayQuota int
@@ -15,19 +21,37 @@ type Issuer struct {
 
 // ACME is the model for ACME configuration.
 type ACME struct {
-	Email                      string
-	PrivateKey                 *string // #nosec G117 -- false positive: optional field to provide private key for ACME issuer
-	Server                     string
-	PrivateKeySecretName       string
+	Email                string
+	PrivateKey           *string // #nosec G117 -- false positive: optional field to provide private key for ACME issuer
+	Server               string
+	PrivateKeySecretName string
+	// SourceSecret is the secret referenced in the extension configuration, which is copied to PrivateKeySecretName.
+	SourceSecret               *client.ObjectKey `json:",omitempty"`
 	ExternalAccountBinding     *ExternalAccountBinding
 	SkipDNSChallengeValidation bool
 	Domains                    *Domains
+	// CACertificates is the resource containing custom root certificates to trust the ACME server.
+	CACertificates *ReferencedResource `json:",omitempty"`
+}
+
+// AutoRegistration returns true if neither a private key nor a private key secret is given.
+// In this case the account is registered by the cert-controller-manager, which stores the generated key in the issuer secret.
+func (a *ACME) AutoRegistration() bool {
+	return a.PrivateKeySecretName == "" && a.PrivateKey == nil
+}
+
+// ReferencedResource is the configuration model for a secret or config map in the namespace of the deployment.
+type ReferencedResource struct {
+	Kind string
+	Name string
 }
 
 // ExternalAccountBinding is the configuration model for ExternalAccountBinding.
 type ExternalAccountBinding struct {
 	KeyID         string
 	KeySecretName string
+	// SourceSecret is the secret referenced in the extension configuration, which is copied to KeySecretName.
+	SourceSecret *client.ObjectKey `json:",omitempty"`
 }
 
 // Domains is the configuration model for Domains.
@@ -38,6 +62,9 @@ type Domains struct {
 
 // CA is the model for CA configuration.
 type CA struct {
-	Certificate    string
-	CertificateKey string
+	Certificate          string
+	CertificateKey       string
+	PrivateKeySecretName string
+	// SourceSecret is the secret referenced in the extension configuration, which is copied to PrivateKeySecretName.
+	SourceSecret *client.ObjectKey `json:",omitempty"`
 }
@@ -0,0 +1,273 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+)
+
+// ReferencedSecrets returns the keys of the issuer secrets referenced in the extension configuration.
+// Secrets referenced without namespace are located in the given namespace of the extension.
+func ReferencedSecrets(extensionConfig config.Configuration, extensionNamespace string) ([]client.ObjectKey, error) {
+	keys := sets.New[client.ObjectKey]()
+	var errs []error
+	add := func(ref *corev1.SecretReference) {
+		if ref == nil {
+			return
+		}
+		key, err := referencedSecretKey(ref, extensionNamespace)
+		if err != nil {
+			errs = append(errs, err)
+			return
+		}
+		keys.Insert(key)
+	}
+	addACME := func(acme *config.ACME) {
+		if acme == nil {
+			return
+		}
+		add(acme.PrivateKeySecretRef)
+		if acme.ExternalAccountBinding != nil {
+			add(acme.ExternalAccountBinding.KeySecretRef)
+		}
+	}
+	addCatalogIssuer := func(issuer config.CatalogIssuer) {
+		if acme := issuer.ACME; acme != nil {
+			add(acme.PrivateKeySecretRef)
+			if acme.ExternalAccountBinding != nil {
+				add(acme.ExternalAccountBinding.KeySecretRef)
+			}
+		}
+		if ca := issuer.CA; ca != nil {
+			add(ca.SecretRef)
+		}
+	}
+	addCA := func(ca *config.CA) {
+		if ca != nil {
+			add(ca.SecretRef)
+		}
+	}
+
+	addACME(extensionConfig.ACME)
+	addCA(extensionConfig.CA)
+	for _, override := range extensionConfig.Overrides {
+		addACME(override.ACME)
+	}
+	for _, catalogIssuer := range extensionConfig.IssuerCatalog {
+		addCatalogIssuer(catalogIssuer)
+	}
+	if fallback := extensionConfig.DefaultIssuerFallback; fallback != nil {
+		for _, fallbackIssuer := range fallback.Issuers {
+			addCatalogIssuer(fallbackIssuer)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	result := keys.UnsortedList()
+	slices.SortFunc(result, func(a, b client.ObjectKey) int {
+		return cmp.Or(strings.Compare(a.Namespace, b.Namespace), strings.Compare(a.Name, b.Name))
+	})
+	return result, nil
+}
+
+// referencedSecretKey returns the key of a secret referenced in the extension configuration.
+// If no namespace is specified, the secret is located in the given namespace of the extension.
+func referencedSecretKey(ref *corev1.SecretReference, extensionNamespace string) (client.ObjectKey, error) {
+	namespace := ref.Namespace
+	if namespace == "" {
+		if extensionNamespace == "" {
+			return client.ObjectKey{}, fmt.Errorf("secret %s is referenced without namespace, but the namespace of the extension is unknown", ref.Name)
+		}
+		namespace = extensionNamespace
+	}
+	return client.ObjectKey{Namespace: namespace, Name: ref.Name}, nil
+}
+
+// referencedIssuerSecret returns the name of the issuer secret in the namespace of the deployment for a secret referenced
+// in the extension configuration. A secret in the namespace of the deployment is used directly. Secrets in other
+// namespaces are copied by the deployer, in this case the key of the source secret is returned additionally.
+// The referenced secrets are checked by collectIssuers before.
+func (d *Deployer) referencedIssuerSecret(ref *corev1.SecretReference, name string) (string, *client.ObjectKey) {
+	key := client.ObjectKey{Namespace: cmp.Or(ref.Namespace, d.values.ExtensionNamespace), Name: ref.Name}
+	if key.Namespace == d.values.Namespace {
+		return key.Name, nil
+	}
+	return fmt.Sprintf("extension-shoot-cert-service-issuer-%s-copy", name), &key
+}
+
+// caModel creates the model of a CA issuer with the given name from the extension configuration.
+func (d *Deployer) caModel(issuerName string, ca *config.CA) *CA {
+	if ca.SecretRef == nil {
+		return &CA{
+			Certificate:    ca.Certificate,
+			CertificateKey: ca.CertificateKey,
+		}
+	}
+	model := &CA{}
+	model.PrivateKeySecretName, model.SourceSecret = d.referencedIssuerSecret(ca.SecretRef, issuerName+"-ca")
+	return model
+}
+
+// caCertificate returns the certificate of a CA issuer of the extension configuration.
+func (d *Deployer) caCertificate(ctx context.Context, c client.Client, ca *config.CA) (string, error) {
+	if ca.SecretRef == nil {
+		return ca.Certificate, nil
+	}
+	key, err := referencedSecretKey(ca.SecretRef, d.values.ExtensionNamespace)
+	if err != nil {
+		return "", err
+	}
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, key, secret); err != nil {
+		return "", fmt.Errorf("failed to read referenced CA secret %s: %w", key, err)
+	}
+	return string(secret.Data[corev1.TLSCertKey]), nil
+}
+
+// deployIssuerSecretCopies copies the issuer secrets referenced in the extension configuration to the namespace of the
+// deployment. The copies are not part of the managed resource to avoid storing the key material in its secrets.
+// Copies which are not needed anymore are deleted.
+func (d *Deployer) deployIssuerSecretCopies(ctx context.Context, c client.Client, issuers []Issuer) error {
+	desired := sets.New[string]()
+	for _, issuer := range issuers {
+		copies := map[string]*client.ObjectKey{}
+		if issuer.ACME != nil {
+			copies[issuer.ACME.PrivateKeySecretName] = issuer.ACME.SourceSecret
+			if eab := issuer.ACME.ExternalAccountBinding; eab != nil {
+				copies[eab.KeySecretName] = eab.SourceSecret
+			}
+		}
+		if issuer.CA != nil {
+			copies[issuer.CA.PrivateKeySecretName] = issuer.CA.SourceSecret
+		}
+		for name, source := range copies {
+			if source == nil {
+				continue
+			}
+			if err := d.copyIssuerSecret(ctx, c, name, *source); err != nil {
+				return fmt.Errorf("failed to copy secret for issuer %s: %w", issuer.Name, err)
+			}
+			desired.Insert(name)
+		}
+	}
+	return d.deleteIssuerSecretCopies(ctx, c, desired)
+}
+
+func (d *Deployer) copyIssuerSecret(ctx context.Context, c client.Client, name string, source client.ObjectKey) error {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, source, secret); err != nil {
+		return fmt.Errorf("failed to read referenced secret %s: %w", source, err)
+	}
+
+	secretCopy := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: d.values.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, c, secretCopy, func() error {
+		metav1.SetMetaDataLabel(&secretCopy.ObjectMeta, LabelIssuerSecretCopy, "true")
+		metav1.SetMetaDataAnnotation(&secretCopy.ObjectMeta, AnnotationCopiedFrom, source.String())
+		secretCopy.Type = secret.Type
+		secretCopy.Data = secret.Data
+		return nil
+	})
+	return err
+}
+
+// deleteIssuerSecretCopies deletes the copied issuer secrets in the namespace of the deployment except the given ones.
+func (d *Deployer) deleteIssuerSecretCopies(ctx context.Context, c client.Client, keep sets.Set[string]) error {
+	secretList := &corev1.SecretList{}
+	if err := c.List(ctx, secretList, client.InNamespace(d.values.Namespace), client.MatchingLabels{LabelIssuerSecretCopy: "true"}); err != nil {
+		return fmt.Errorf("failed to list copied issuer secrets: %w", err)
+	}
+	for i := range secretList.Items {
+		if keep.Has(secretList.Items[i].Name) {
+			continue
+		}
+		if err := c.Delete(ctx, &secretList.Items[i]); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete copied issuer secret %s: %w", secretList.Items[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// WatchReferencedSecrets returns a watch function enqueuing all Extension resources of the given type if an issuer
+// secret referenced in the extension configuration is changed. Only the namespaces of the referenced secrets are watched.
+// Secrets referenced without namespace are located in the given namespace of the extension.
+func WatchReferencedSecrets(mgr manager.Manager, extensionConfig config.Configuration, extensionNamespace, extensionType string) func(controller.Controller) error {
+	return func(c controller.Controller) error {
+		keys, err := ReferencedSecrets(extensionConfig, extensionNamespace)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+
+		namespaces := map[string]cache.Config{}
+		for _, key := range keys {
+			namespaces[key.Namespace] = cache.Config{}
+		}
+		secretCache, err := cache.New(mgr.GetConfig(), cache.Options{
+			Scheme:            mgr.GetScheme(),
+			Mapper:            mgr.GetRESTMapper(),
+			DefaultNamespaces: namespaces,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create cache for referenced secrets: %w", err)
+		}
+		if err := mgr.Add(secretCache); err != nil {
+			return err
+		}
+
+		return c.Watch(source.Kind(
+			secretCache,
+			&corev1.Secret{},
+			handler.TypedEnqueueRequestsFromMapFunc(mapSecretToExtensions(mgr.GetClient(), extensionType, mgr.GetLogger().WithName("mapSecretToExtensions"))),
+			predicate.NewTypedPredicateFuncs(func(secret *corev1.Secret) bool {
+				return slices.Contains(keys, client.ObjectKeyFromObject(secret))
+			}),
+		))
+	}
+}
+
+func mapSecretToExtensions(c client.Reader, extensionType string, log logr.Logger) func(context.Context, *corev1.Secret) []reconcile.Request {
+	return func(ctx context.Context, secret *corev1.Secret) []reconcile.Request {
+		extList := &extensionsv1alpha1.ExtensionList{}
+		if err := c.List(ctx, extList); err != nil {
+			log.Error(err, "Failed to list extensions")
+			return nil
+		}
+		log.Info("Referenced issuer secret has changed, requeueing extensions", "secret", client.ObjectKeyFromObject(secret), "type", extensionType)
+
+		var requests []reconcile.Request
+		for _, ex := range extList.Items {
+			if ex.Spec.Type == extensionType && ex.DeletionTimestamp == nil {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&ex)})
+			}
+		}
+		return requests
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+)
+
+var _ = Describe("IssuerSecrets", func() {
+	It("should return the referenced secrets of the extension configuration", func() {
+		extensionConfig := config.Configuration{
+			ACME: &config.ACME{
+				PrivateKeySecretRef:    &corev1.SecretReference{Name: "acme-account"},
+				ExternalAccountBinding: &config.ACMEExternalAccountBinding{KeyID: "key-id", KeySecretRef: &corev1.SecretReference{Name: "acme-eab", Namespace: "garden"}},
+			},
+			Overrides: []config.ConfigurationOverride{
+				{Name: "eu", ACME: &config.ACME{PrivateKeySecretRef: &corev1.SecretReference{Name: "acme-account-eu", Namespace: "garden"}}},
+				{Name: "us", ACME: &config.ACME{PrivateKeySecretRef: &corev1.SecretReference{Name: "acme-account"}}},
+			},
+			IssuerCatalog: []config.CatalogIssuer{
+				{Name: "corp-ca", CA: &config.CA{SecretRef: &corev1.SecretReference{Name: "corp-ca", Namespace: "garden"}}},
+				{Name: "zerossl", ACME: &config.CatalogACME{
+					PrivateKeySecretRef:    &corev1.SecretReference{Name: "zerossl-account", Namespace: "garden"},
+					ExternalAccountBinding: &config.ACMEExternalAccountBinding{KeyID: "key-id", KeySecretRef: &corev1.SecretReference{Name: "zerossl-eab", Namespace: "garden"}},
+				}},
+			},
+			DefaultIssuerFallback: &config.DefaultIssuerFallback{
+				Issuers: []config.CatalogIssuer{
+					{Name: "fallback", ACME: &config.CatalogACME{PrivateKeySecretRef: &corev1.SecretReference{Name: "fallback-account"}}},
+				},
+			},
+		}
+
+		keys, err := ReferencedSecrets(extensionConfig, "extension-shoot-cert-service-abc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keys).To(Equal([]client.ObjectKey{
+			{Namespace: "extension-shoot-cert-service-abc", Name: "acme-account"},
+			{Namespace: "extension-shoot-cert-service-abc", Name: "fallback-account"},
+			{Namespace: "garden", Name: "acme-account-eu"},
+			{Namespace: "garden", Name: "acme-eab"},
+			{Namespace: "garden", Name: "corp-ca"},
+			{Namespace: "garden", Name: "zerossl-account"},
+			{Namespace: "garden", Name: "zerossl-eab"},
+		}))
+		Expect(ReferencedSecrets(config.Configuration{ACME: &config.ACME{PrivateKey: new("key")}}, "extension-shoot-cert-service-abc")).To(BeEmpty())
+
+		_, err = ReferencedSecrets(extensionConfig, "")
+		Expect(err).To(MatchError(ContainSubstring("secret acme-account is referenced without namespace, but the namespace of the extension is unknown")))
+	})
+
+	It("should map a referenced secret to all extensions of the type", func() {
+		scheme := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		c := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&extensionsv1alpha1.Extension{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot-cert-service", Namespace: "shoot--foo--bar"},
+				Spec:       extensionsv1alpha1.ExtensionSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "shoot-cert-service"}},
+			},
+			&extensionsv1alpha1.Extension{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot-dns-service", Namespace: "shoot--foo--bar"},
+				Spec:       extensionsv1alpha1.ExtensionSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "shoot-dns-service"}},
+			},
+		).Build()
+
+		requests := mapSecretToExtensions(c, "shoot-cert-service", logr.Discard())(context.Background(), &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "acme-account", Namespace: "garden"},
+		})
+		Expect(requests).To(ConsistOf(reconcile.Request{NamespacedName: client.ObjectKey{Namespace: "shoot--foo--bar", Name: "shoot-cert-service"}}))
+	})
+})
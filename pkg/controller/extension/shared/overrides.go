@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"slices"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+)
+
+// EffectiveConfiguration returns the extension configuration with all overrides applied which select the given seed,
+// and the names of the applied overrides.
+func EffectiveConfiguration(extensionConfig config.Configuration, seed *gardencorev1beta1.Seed) (config.Configuration, []string) {
+	if len(extensionConfig.Overrides) == 0 || seed == nil {
+		return extensionConfig, nil
+	}
+
+	effective := *extensionConfig.DeepCopy()
+	var applied []string
+	for _, override := range effective.Overrides {
+		if !overrideSelectsSeed(override, seed) {
+			continue
+		}
+		if override.ACME != nil && effective.ACME != nil {
+			mergeACME(effective.ACME, override.ACME)
+		}
+		if override.Proxy != nil {
+			if effective.Proxy == nil {
+				effective.Proxy = &config.Proxy{}
+			}
+			mergeProxy(effective.Proxy, override.Proxy)
+		}
+		applied = append(applied, override.Name)
+	}
+	return effective, applied
+}
+
+// overrideSelectsSeed checks if the seed matches all selectors of the override.
+func overrideSelectsSeed(override config.ConfigurationOverride, seed *gardencorev1beta1.Seed) bool {
+	if len(override.SeedNames) > 0 && !slices.Contains(override.SeedNames, seed.Name) {
+		return false
+	}
+	if len(override.Regions) > 0 && !slices.Contains(override.Regions, seed.Spec.Provider.Region) {
+		return false
+	}
+	if override.SeedSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(override.SeedSelector)
+		if err != nil || !selector.Matches(labels.Set(seed.Labels)) {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeACME overrides the fields of the ACME configuration which are specified in the override.
+func mergeACME(acme, override *config.ACME) {
+	if override.Email != "" {
+		acme.Email = override.Email
+	}
+	if override.Server != "" {
+		acme.Server = override.Server
+	}
+	if override.PrivateKey != nil {
+		acme.PrivateKey = override.PrivateKey
+		acme.PrivateKeySecretRef = nil
+	}
+	if override.PrivateKeySecretRef != nil {
+		acme.PrivateKeySecretRef = override.PrivateKeySecretRef
+		acme.PrivateKey = nil
+	}
+	if override.PropagationTimeout != nil {
+		acme.PropagationTimeout = override.PropagationTimeout
+	}
+	if override.PrecheckAdditionalWait != nil {
+		acme.PrecheckAdditionalWait = override.PrecheckAdditionalWait
+	}
+	if override.PrecheckNameservers != nil {
+		acme.PrecheckNameservers = override.PrecheckNameservers
+	}
+	if override.CACertificates != nil {
+		acme.CACertificates = override.CACertificates
+	}
+	if override.DeactivateAuthorizations != nil {
+		acme.DeactivateAuthorizations = override.DeactivateAuthorizations
+	}
+	if override.SkipDNSChallengeValidation != nil {
+		acme.SkipDNSChallengeValidation = override.SkipDNSChallengeValidation
+	}
+	if override.ExternalAccountBinding != nil {
+		acme.ExternalAccountBinding = override.ExternalAccountBinding
+	}
+	if override.Domains != nil {
+		acme.Domains = override.Domains
+	}
+	if override.PreferredChain != nil {
+		acme.PreferredChain = override.PreferredChain
+	}
+	if override.KeyType != nil {
+		acme.KeyType = override.KeyType
+	}
+}
+
+// mergeProxy overrides the fields of the proxy configuration which are specified in the override.
+func mergeProxy(proxy, override *config.Proxy) {
+	if override.HTTPProxy != nil {
+		proxy.HTTPProxy = override.HTTPProxy
+	}
+	if override.HTTPSProxy != nil {
+		proxy.HTTPSProxy = override.HTTPSProxy
+	}
+	if override.NoProxy != nil {
+		proxy.NoProxy = override.NoProxy
+	}
+}
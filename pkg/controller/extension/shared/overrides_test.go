@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+)
+
+var _ = Describe("EffectiveConfiguration", func() {
+	var (
+		extensionConfig config.Configuration
+		seed            *gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		extensionConfig = config.Configuration{
+			IssuerName: "garden",
+			ACME: &config.ACME{
+				Email:               "foo@example.com",
+				Server:              "https://acme-v02.api.letsencrypt.org/directory",
+				PrecheckNameservers: new("8.8.8.8"),
+			},
+			Overrides: []config.ConfigurationOverride{
+				{
+					Name:    "eu",
+					Regions: []string{"eu-west-1", "eu-central-1"},
+					ACME: &config.ACME{
+						Server:              "https://acme.eu.example.com/directory",
+						PrecheckNameservers: new("10.0.0.53"),
+						PreferredChain:      new("EU Root"),
+						KeyType:             new("ECDSA256"),
+					},
+				},
+				{
+					Name:         "restricted",
+					SeedNames:    []string{"aws-eu1"},
+					SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"network": "restricted"}},
+					Proxy:        &config.Proxy{HTTPSProxy: new("http://proxy.example.com:3128")},
+				},
+			},
+		}
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "aws-eu1", Labels: map[string]string{"network": "restricted"}},
+			Spec: gardencorev1beta1.SeedSpec{
+				Provider: gardencorev1beta1.SeedProvider{Region: "eu-west-1"},
+			},
+		}
+	})
+
+	It("should apply all overrides selecting the seed in order", func() {
+		effective, applied := EffectiveConfiguration(extensionConfig, seed)
+		Expect(applied).To(Equal([]string{"eu", "restricted"}))
+		Expect(effective.ACME).To(Equal(&config.ACME{
+			Email:               "foo@example.com",
+			Server:              "https://acme.eu.example.com/directory",
+			PrecheckNameservers: new("10.0.0.53"),
+			PreferredChain:      new("EU Root"),
+			KeyType:             new("ECDSA256"),
+		}))
+		Expect(effective.Proxy).To(Equal(&config.Proxy{HTTPSProxy: new("http://proxy.example.com:3128")}))
+		Expect(extensionConfig.ACME.Server).To(Equal("https://acme-v02.api.letsencrypt.org/directory"), "the configuration must not be modified")
+	})
+
+	It("should only apply overrides matching all selectors", func() {
+		seed.Labels = nil
+		effective, applied := EffectiveConfiguration(extensionConfig, seed)
+		Expect(applied).To(Equal([]string{"eu"}))
+		Expect(effective.Proxy).To(BeNil())
+
+		seed.Spec.Provider.Region = "us-east-1"
+		effective, applied = EffectiveConfiguration(extensionConfig, seed)
+		Expect(applied).To(BeEmpty())
+		Expect(effective.ACME.Server).To(Equal("https://acme-v02.api.letsencrypt.org/directory"))
+	})
+
+	It("should not apply overrides without seed", func() {
+		effective, applied := EffectiveConfiguration(extensionConfig, nil)
+		Expect(applied).To(BeEmpty())
+		Expect(effective).To(Equal(extensionConfig))
+	})
+})
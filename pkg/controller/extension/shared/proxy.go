@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"golang.org/x/net/http/httpproxy"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EnvKubernetesServiceHost is the environment variable containing the address of the kube-apiserver service.
+const EnvKubernetesServiceHost = "KUBERNETES_SERVICE_HOST"
+
+// SeedKubeAPIServerHost returns the address of the kube-apiserver service of the cluster the extension is running in.
+// The cert-controller-manager reaches it with its in-cluster configuration, so it must be excluded from the proxy.
+// The address is taken from the environment of the extension and read from the `kubernetes` service otherwise.
+func SeedKubeAPIServerHost(ctx context.Context, c client.Reader) (string, error) {
+	if host := os.Getenv(EnvKubernetesServiceHost); host != "" {
+		return host, nil
+	}
+	service := &corev1.Service{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "kubernetes"}, service); err != nil {
+		return "", fmt.Errorf("failed to get kube-apiserver service: %w", err)
+	}
+	return service.Spec.ClusterIP, nil
+}
+
+// noProxy returns the addresses excluded from the proxy of the extension configuration.
+// The kube-apiserver of the shoot and seed and cluster internal services are always reached directly.
+func (v Values) noProxy() string {
+	noProxy := defaultNoProxy
+	if v.SeedKubeAPIServerHost != "" {
+		noProxy += "," + v.SeedKubeAPIServerHost
+	}
+	if proxy := v.ExtensionConfig.Proxy; proxy != nil && proxy.NoProxy != nil && *proxy.NoProxy != "" {
+		noProxy += "," + *proxy.NoProxy
+	}
+	return noProxy
+}
+
+// proxyFunc returns the proxy function for requests of the extension to ACME servers. Like the cert-controller-manager,
+// the requests use the proxy of the extension configuration. Without proxy, nil is returned.
+func (v Values) proxyFunc() func(*http.Request) (*url.URL, error) {
+	proxy := v.ExtensionConfig.Proxy
+	if proxy == nil {
+		return nil
+	}
+	proxyConfig := &httpproxy.Config{
+		HTTPProxy:  ptr.Deref(proxy.HTTPProxy, ""),
+		HTTPSProxy: ptr.Deref(proxy.HTTPSProxy, ""),
+		NoProxy:    v.noProxy(),
+	}
+	proxyURL := proxyConfig.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyURL(req.URL)
+	}
+}
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("SeedKubeAPIServerHost", func() {
+	var (
+		ctx = context.Background()
+		c   client.Client
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithObjects(
+			&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "kubernetes", Namespace: metav1.NamespaceDefault},
+				Spec:       corev1.ServiceSpec{ClusterIP: "100.64.0.1"},
+			},
+		).Build()
+	})
+
+	It("should return the address from the environment", func() {
+		GinkgoT().Setenv(EnvKubernetesServiceHost, "10.0.0.1")
+		Expect(SeedKubeAPIServerHost(ctx, c)).To(Equal("10.0.0.1"))
+	})
+
+	It("should return the cluster IP of the kubernetes service without environment", func() {
+		GinkgoT().Setenv(EnvKubernetesServiceHost, "")
+		Expect(SeedKubeAPIServerHost(ctx, c)).To(Equal("100.64.0.1"))
+	})
+
+	It("should fail if the kubernetes service is missing", func() {
+		GinkgoT().Setenv(EnvKubernetesServiceHost, "")
+		_, err := SeedKubeAPIServerHost(ctx, fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build())
+		Expect(err).To(MatchError(ContainSubstring("failed to get kube-apiserver service")))
+	})
+})
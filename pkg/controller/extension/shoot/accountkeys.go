@@ -0,0 +1,260 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gardener/cert-management/pkg/shared/legobridge"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-acme/lego/v5/acme"
+	"github.com/go-acme/lego/v5/lego"
+	"github.com/go-logr/logr"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/v1alpha1"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shared"
+)
+
+// acmeAccountSecretResources returns the issuer secrets of the auto-registered ACME issuers as resources of the Extension
+// status. The secrets contain the generated account keys and are exported on control plane migration, so that the accounts
+// are kept on the destination seed. The new keys of pending key rotations are exported as well, as the account may already
+// use the new key if the rollover has succeeded at the ACME server. Secrets which have not been created yet are skipped.
+func (a *actuator) acmeAccountSecretResources(ctx context.Context, values shared.Values) ([]gardencorev1beta1.NamedResourceReference, error) {
+	issuers, err := shared.NewDeployer(values).AutoRegisteredACMEIssuers()
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []gardencorev1beta1.NamedResourceReference
+	for _, issuer := range issuers {
+		name := "extension-shoot-cert-service-issuer-" + issuer.Name
+		for _, secretName := range []string{name, name + "-next"} {
+			if err := a.client.Get(ctx, client.ObjectKey{Namespace: values.Namespace, Name: secretName}, &corev1.Secret{}); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to read issuer secret %s of issuer %s: %w", secretName, issuer.Name, err)
+			}
+			resources = append(resources, gardencorev1beta1.NamedResourceReference{
+				Name: secretName,
+				ResourceRef: autoscalingv1.CrossVersionObjectReference{
+					Kind:       "Secret",
+					Name:       secretName,
+					APIVersion: "v1",
+				},
+			})
+		}
+	}
+	return resources, nil
+}
+
+// rotateACMEAccountKeys rolls over the account keys of the auto-registered ACME issuers of the shoot and returns the
+// rotations with the updated rotation times. Only these accounts belong to the shoot, their keys are stored in the issuer
+// secrets in the shoot namespace. Keys in secrets referenced in the extension configuration are shared by many shoots and
+// are not rotated per shoot. Issuers without issuer secret have not registered an account yet and are skipped, as well as
+// issuers whose server is not allowed by the compliance profile or the custom issuer policy. If rotations fail, the other
+// issuers are rotated nevertheless and the updated rotations are returned together with the error.
+func (a *actuator) rotateACMEAccountKeys(ctx context.Context, log logr.Logger, values shared.Values, rotations []v1alpha1.ACMEAccountKeyRotation, now time.Time) ([]v1alpha1.ACMEAccountKeyRotation, error) {
+	d := shared.NewDeployer(values)
+	issuers, err := d.AutoRegisteredACMEIssuers()
+	if err != nil {
+		return rotations, err
+	}
+
+	var errs []error
+	for _, issuer := range issuers {
+		if !values.IssuerServerAllowed(issuer) {
+			log.Info("ACME server of issuer not allowed, skipping key rotation", "issuer", issuer.Name)
+			continue
+		}
+
+		secret := &corev1.Secret{}
+		if err := a.client.Get(ctx, client.ObjectKey{Namespace: values.Namespace, Name: "extension-shoot-cert-service-issuer-" + issuer.Name}, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Info("ACME account of issuer not registered yet, skipping key rotation", "issuer", issuer.Name)
+				continue
+			}
+			errs = append(errs, fmt.Errorf("failed to read issuer secret of issuer %s: %w", issuer.Name, err))
+			continue
+		}
+		httpClient, err := d.ACMEHTTPClient(ctx, a.client, issuer)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to create ACME client of issuer %s: %w", issuer.Name, err))
+			continue
+		}
+		if err := a.rotateACMEAccountKey(ctx, secret, issuer.ACME.Server, httpClient); err != nil {
+			errs = append(errs, fmt.Errorf("failed to rotate ACME account key of issuer %s: %w", issuer.Name, err))
+			continue
+		}
+		log.Info("Rotated ACME account key", "issuer", issuer.Name)
+		rotations = setACMEAccountKeyRotation(rotations, issuer.Name, now)
+	}
+	return rotations, errors.Join(errs...)
+}
+
+// rotateACMEAccountKey rolls over the account key stored in the given issuer secret. The new key is stored in a
+// separate secret before the rollover, so that it is not lost if updating the issuer secret fails afterwards.
+func (a *actuator) rotateACMEAccountKey(ctx context.Context, secret *corev1.Secret, server string, httpClient *http.Client) error {
+	key := client.ObjectKeyFromObject(secret)
+	oldKey, err := legobridge.BytesToPrivateKey(secret.Data[legobridge.KeyPrivateKey])
+	if err != nil {
+		return fmt.Errorf("failed to decode private key of secret %s: %w", key, err)
+	}
+
+	nextSecret, err := a.getOrCreateNextACMEAccountKeySecret(ctx, key)
+	if err != nil {
+		return err
+	}
+	newKey, err := legobridge.BytesToPrivateKey(nextSecret.Data[legobridge.KeyPrivateKey])
+	if err != nil {
+		return fmt.Errorf("failed to decode private key of secret %s: %w", client.ObjectKeyFromObject(nextSecret), err)
+	}
+
+	if err := a.rollOverACMEAccountKey(ctx, server, httpClient, oldKey, newKey); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(secret.DeepCopy())
+	secret.Data[legobridge.KeyPrivateKey] = nextSecret.Data[legobridge.KeyPrivateKey]
+	if err := a.client.Patch(ctx, secret, patch); err != nil {
+		return fmt.Errorf("failed to store rotated private key in secret %s: %w", key, err)
+	}
+	return client.IgnoreNotFound(a.client.Delete(ctx, nextSecret))
+}
+
+// getOrCreateNextACMEAccountKeySecret returns the secret with the new account key of the given issuer secret.
+// The key is generated if the secret does not exist yet.
+func (a *actuator) getOrCreateNextACMEAccountKeySecret(ctx context.Context, key client.ObjectKey) (*corev1.Secret, error) {
+	nextSecret := &corev1.Secret{}
+	err := a.client.Get(ctx, client.ObjectKey{Namespace: key.Namespace, Name: key.Name + "-next"}, nextSecret)
+	if err == nil || !apierrors.IsNotFound(err) {
+		return nextSecret, err
+	}
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate private key: %w", err)
+	}
+	der, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode private key: %w", err)
+	}
+	nextSecret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name + "-next",
+			Namespace: key.Namespace,
+			Labels:    map[string]string{shared.LabelNextACMEAccountKey: key.Name},
+		},
+		Data: map[string][]byte{
+			legobridge.KeyPrivateKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}),
+		},
+	}
+	if err := a.client.Create(ctx, nextSecret); err != nil {
+		return nil, fmt.Errorf("failed to create secret %s: %w", client.ObjectKeyFromObject(nextSecret), err)
+	}
+	return nextSecret, nil
+}
+
+// deleteNextACMEAccountKeySecrets deletes the secrets with the new account keys of pending rotations in the given namespace.
+func (a *actuator) deleteNextACMEAccountKeySecrets(ctx context.Context, namespace string) error {
+	if err := a.client.DeleteAllOf(ctx, &corev1.Secret{}, client.InNamespace(namespace), client.HasLabels{shared.LabelNextACMEAccountKey}); err != nil {
+		return fmt.Errorf("failed to delete secrets of pending ACME account key rotations: %w", err)
+	}
+	return nil
+}
+
+// rollOverACMEAccountKey changes the key of the ACME account of the old key to the new key.
+// If the account is already resolved by the new key, a previous rollover has succeeded and nothing is done.
+func rollOverACMEAccountKey(ctx context.Context, server string, httpClient *http.Client, oldKey, newKey crypto.Signer) error {
+	newClient, err := newACMEAccountClient(server, httpClient, newKey)
+	if err != nil {
+		return err
+	}
+	if _, err := newClient.Registration.ResolveAccountByKey(ctx); err == nil {
+		return nil
+	}
+
+	oldClient, err := newACMEAccountClient(server, httpClient, oldKey)
+	if err != nil {
+		return err
+	}
+	if _, err := oldClient.Registration.ResolveAccountByKey(ctx); err != nil {
+		return fmt.Errorf("failed to resolve ACME account: %w", err)
+	}
+	if err := oldClient.Registration.KeyRollover(ctx, newKey); err != nil {
+		return fmt.Errorf("failed to roll over ACME account key: %w", err)
+	}
+	return nil
+}
+
+func newACMEAccountClient(server string, httpClient *http.Client, key crypto.Signer) (*lego.Client, error) {
+	config := lego.NewConfig(&acmeAccountUser{key: key})
+	config.CADirURL = server
+	config.HTTPClient = httpClient
+	return lego.NewClient(config)
+}
+
+// acmeAccountUser is the ACME user of an existing account identified by its key.
+type acmeAccountUser struct {
+	key crypto.Signer
+}
+
+func (u *acmeAccountUser) GetEmail() string {
+	return ""
+}
+
+func (u *acmeAccountUser) GetRegistration() *acme.ExtendedAccount {
+	return nil
+}
+
+func (u *acmeAccountUser) GetPrivateKey() crypto.Signer {
+	return u.key
+}
+
+// setACMEAccountKeyRotation sets the rotation time of the given issuer. The rotations are sorted by issuer name.
+func setACMEAccountKeyRotation(rotations []v1alpha1.ACMEAccountKeyRotation, issuer string, now time.Time) []v1alpha1.ACMEAccountKeyRotation {
+	rotations = slices.DeleteFunc(slices.Clone(rotations), func(rotation v1alpha1.ACMEAccountKeyRotation) bool {
+		return rotation.Issuer == issuer
+	})
+	rotations = append(rotations, v1alpha1.ACMEAccountKeyRotation{Issuer: issuer, LastRotationTime: metav1.NewTime(now)})
+	slices.SortFunc(rotations, func(a, b v1alpha1.ACMEAccountKeyRotation) int {
+		return strings.Compare(a.Issuer, b.Issuer)
+	})
+	return rotations
+}
+
+// previousACMEAccountKeyRotations returns the account key rotations from the provider status of the Extension resource.
+func previousACMEAccountKeyRotations(ex *extensionsv1alpha1.Extension) []v1alpha1.ACMEAccountKeyRotation {
+	if ex.Status.ProviderStatus == nil {
+		return nil
+	}
+	if status, ok := ex.Status.ProviderStatus.Object.(*v1alpha1.CertStatus); ok {
+		return status.ACMEAccountKeyRotations
+	}
+	status := &v1alpha1.CertStatus{}
+	if err := json.Unmarshal(ex.Status.ProviderStatus.Raw, status); err != nil {
+		return nil
+	}
+	return status.ACMEAccountKeyRotations
+}
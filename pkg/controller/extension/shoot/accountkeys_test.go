@@ -0,0 +1,297 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gardener/cert-management/pkg/shared/legobridge"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/v1alpha1"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shared"
+)
+
+var _ = Describe("ACMEAccountKeys", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+		now = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+		c          client.Client
+		a          *actuator
+		values     shared.Values
+		rollovers  map[string][2]crypto.Signer
+		rolloverFn func(ctx context.Context, server string, httpClient *http.Client, oldKey, newKey crypto.Signer) error
+
+		newPrivateKey = func() []byte {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			der, err := x509.MarshalECPrivateKey(key)
+			Expect(err).NotTo(HaveOccurred())
+			return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+		}
+		getSecret = func(name string) (*corev1.Secret, error) {
+			secret := &corev1.Secret{}
+			return secret, c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret)
+		}
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+		rollovers = map[string][2]crypto.Signer{}
+		rolloverFn = func(_ context.Context, server string, httpClient *http.Client, oldKey, newKey crypto.Signer) error {
+			Expect(httpClient).NotTo(BeNil())
+			rollovers[server] = [2]crypto.Signer{oldKey, newKey}
+			return nil
+		}
+		a = &actuator{
+			client: c,
+			rollOverACMEAccountKey: func(ctx context.Context, server string, httpClient *http.Client, oldKey, newKey crypto.Signer) error {
+				return rolloverFn(ctx, server, httpClient, oldKey, newKey)
+			},
+		}
+		values = shared.Values{
+			ExtensionConfig: config.Configuration{
+				IssuerName: "garden",
+				ACME:       &config.ACME{Email: "foo@example.com", Server: "https://acme.example.com/directory"},
+			},
+			CertConfig: service.CertConfig{
+				Issuers: []service.IssuerConfig{
+					{Name: "custom", Email: "bar@example.com", Server: "https://acme.custom.example.com/directory"},
+					{Name: "byok", Email: "bar@example.com", Server: "https://acme.byok.example.com/directory", PrivateKeySecretName: new("byok-key")},
+				},
+			},
+			Namespace:       namespace,
+			ShootDeployment: true,
+			Resources: []gardencorev1beta1.NamedResourceReference{
+				{Name: "byok-key", ResourceRef: autoscalingv1.CrossVersionObjectReference{Kind: "Secret", Name: "byok-key", APIVersion: "v1"}},
+			},
+		}
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "extension-shoot-cert-service-issuer-garden", Namespace: namespace},
+			Data:       map[string][]byte{legobridge.KeyPrivateKey: newPrivateKey()},
+		})).To(Succeed())
+	})
+
+	It("should export the existing issuer secrets of all auto-registered issuers", func() {
+		resources, err := a.acmeAccountSecretResources(ctx, values)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(Equal([]gardencorev1beta1.NamedResourceReference{
+			{
+				Name:        "extension-shoot-cert-service-issuer-garden",
+				ResourceRef: autoscalingv1.CrossVersionObjectReference{Kind: "Secret", Name: "extension-shoot-cert-service-issuer-garden", APIVersion: "v1"},
+			},
+		}), "the account of issuer custom is not registered yet")
+
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "extension-shoot-cert-service-issuer-custom", Namespace: namespace},
+			Data:       map[string][]byte{legobridge.KeyPrivateKey: newPrivateKey()},
+		})).To(Succeed())
+		values.CertConfig.DefaultIssuer = &service.DefaultIssuer{DisableGardenIssuer: true}
+		resources, err = a.acmeAccountSecretResources(ctx, values)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(Equal([]gardencorev1beta1.NamedResourceReference{
+			{
+				Name:        "extension-shoot-cert-service-issuer-custom",
+				ResourceRef: autoscalingv1.CrossVersionObjectReference{Kind: "Secret", Name: "extension-shoot-cert-service-issuer-custom", APIVersion: "v1"},
+			},
+		}))
+	})
+
+	It("should export the new keys of pending rotations", func() {
+		rolloverFn = func(context.Context, string, *http.Client, crypto.Signer, crypto.Signer) error {
+			return errors.New("server unavailable")
+		}
+		_, err := a.rotateACMEAccountKeys(ctx, log, values, nil, now)
+		Expect(err).To(HaveOccurred())
+
+		resources, err := a.acmeAccountSecretResources(ctx, values)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resources).To(Equal([]gardencorev1beta1.NamedResourceReference{
+			{
+				Name:        "extension-shoot-cert-service-issuer-garden",
+				ResourceRef: autoscalingv1.CrossVersionObjectReference{Kind: "Secret", Name: "extension-shoot-cert-service-issuer-garden", APIVersion: "v1"},
+			},
+			{
+				Name:        "extension-shoot-cert-service-issuer-garden-next",
+				ResourceRef: autoscalingv1.CrossVersionObjectReference{Kind: "Secret", Name: "extension-shoot-cert-service-issuer-garden-next", APIVersion: "v1"},
+			},
+		}))
+	})
+
+	It("should keep the exported resources if the issuers cannot be determined", func() {
+		scheme := runtime.NewScheme()
+		Expect(kubernetesscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		previous := []gardencorev1beta1.NamedResourceReference{
+			{
+				Name:        "extension-shoot-cert-service-issuer-garden",
+				ResourceRef: autoscalingv1.CrossVersionObjectReference{Kind: "Secret", Name: "extension-shoot-cert-service-issuer-garden", APIVersion: "v1"},
+			},
+		}
+		ex := &extensionsv1alpha1.Extension{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot-cert-service", Namespace: namespace},
+			Status:     extensionsv1alpha1.ExtensionStatus{DefaultStatus: extensionsv1alpha1.DefaultStatus{Resources: previous}},
+		}
+		a.client = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(ex).WithStatusSubresource(ex).Build()
+
+		values.CertConfig.Issuers = []service.IssuerConfig{{Name: "catalog", CatalogIssuer: new("unknown")}}
+		Expect(a.updateStatus(ctx, log, ex, &values.CertConfig, values, nil, nil, false, false)).To(Succeed())
+
+		Expect(a.client.Get(ctx, client.ObjectKeyFromObject(ex), ex)).To(Succeed())
+		Expect(ex.Status.Resources).To(Equal(previous))
+		Expect(ex.Status.ProviderStatus).NotTo(BeNil())
+	})
+
+	It("should roll over the account keys of registered auto-registered issuers", func() {
+		oldSecret, err := getSecret("extension-shoot-cert-service-issuer-garden")
+		Expect(err).NotTo(HaveOccurred())
+
+		previous := []v1alpha1.ACMEAccountKeyRotation{{Issuer: "zerossl", LastRotationTime: metav1.NewTime(now.Add(-time.Hour))}}
+		rotations, err := a.rotateACMEAccountKeys(ctx, log, values, previous, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rotations).To(Equal([]v1alpha1.ACMEAccountKeyRotation{
+			{Issuer: "garden", LastRotationTime: metav1.NewTime(now)},
+			{Issuer: "zerossl", LastRotationTime: metav1.NewTime(now.Add(-time.Hour))},
+		}))
+
+		Expect(rollovers).To(HaveLen(1))
+		keys := rollovers["https://acme.example.com/directory"]
+		oldKey, err := legobridge.BytesToPrivateKey(oldSecret.Data[legobridge.KeyPrivateKey])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keys[0]).To(Equal(oldKey))
+
+		secret, err := getSecret("extension-shoot-cert-service-issuer-garden")
+		Expect(err).NotTo(HaveOccurred())
+		newKey, err := legobridge.BytesToPrivateKey(secret.Data[legobridge.KeyPrivateKey])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keys[1]).To(Equal(newKey))
+		Expect(newKey).NotTo(Equal(oldKey))
+
+		_, err = getSecret("extension-shoot-cert-service-issuer-garden-next")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should keep the new key for the retry if the rollover fails", func() {
+		rolloverFn = func(context.Context, string, *http.Client, crypto.Signer, crypto.Signer) error {
+			return errors.New("server unavailable")
+		}
+		_, err := a.rotateACMEAccountKeys(ctx, log, values, nil, now)
+		Expect(err).To(MatchError(ContainSubstring("failed to rotate ACME account key of issuer garden: server unavailable")))
+
+		nextSecret, err := getSecret("extension-shoot-cert-service-issuer-garden-next")
+		Expect(err).NotTo(HaveOccurred())
+
+		rolloverFn = func(_ context.Context, server string, _ *http.Client, oldKey, newKey crypto.Signer) error {
+			rollovers[server] = [2]crypto.Signer{oldKey, newKey}
+			return nil
+		}
+		_, err = a.rotateACMEAccountKeys(ctx, log, values, nil, now)
+		Expect(err).NotTo(HaveOccurred())
+
+		secret, err := getSecret("extension-shoot-cert-service-issuer-garden")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.Data[legobridge.KeyPrivateKey]).To(Equal(nextSecret.Data[legobridge.KeyPrivateKey]))
+	})
+
+	It("should not rotate the keys of private key secrets referenced in the extension configuration", func() {
+		oldData := newPrivateKey()
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "acme-key", Namespace: "garden"},
+			Data:       map[string][]byte{legobridge.KeyPrivateKey: oldData},
+		})).To(Succeed())
+		values.ExtensionConfig.ACME.PrivateKeySecretRef = &corev1.SecretReference{Name: "acme-key", Namespace: "garden"}
+
+		rotations, err := a.rotateACMEAccountKeys(ctx, log, values, nil, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rotations).To(BeEmpty())
+		Expect(rollovers).To(BeEmpty())
+
+		source := &corev1.Secret{}
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: "garden", Name: "acme-key"}, source)).To(Succeed())
+		Expect(source.Data[legobridge.KeyPrivateKey]).To(Equal(oldData))
+	})
+
+	It("should return the successful rotations together with the error of a failed rotation", func() {
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "extension-shoot-cert-service-issuer-custom", Namespace: namespace},
+			Data:       map[string][]byte{legobridge.KeyPrivateKey: newPrivateKey()},
+		})).To(Succeed())
+		rolloverFn = func(_ context.Context, server string, _ *http.Client, oldKey, newKey crypto.Signer) error {
+			if server == "https://acme.example.com/directory" {
+				return errors.New("server unavailable")
+			}
+			rollovers[server] = [2]crypto.Signer{oldKey, newKey}
+			return nil
+		}
+
+		rotations, err := a.rotateACMEAccountKeys(ctx, log, values, nil, now)
+		Expect(err).To(MatchError(ContainSubstring("failed to rotate ACME account key of issuer garden: server unavailable")))
+		Expect(rotations).To(Equal([]v1alpha1.ACMEAccountKeyRotation{{Issuer: "custom", LastRotationTime: metav1.NewTime(now)}}))
+		Expect(rollovers).To(HaveKey("https://acme.custom.example.com/directory"))
+	})
+
+	It("should skip issuers whose server is not allowed", func() {
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "extension-shoot-cert-service-issuer-custom", Namespace: namespace},
+			Data:       map[string][]byte{legobridge.KeyPrivateKey: newPrivateKey()},
+		})).To(Succeed())
+		values.ExtensionConfig.CustomIssuerPolicy = &config.CustomIssuerPolicy{DeniedServers: []string{"https://ACME.custom.example.com/directory/"}}
+
+		rotations, err := a.rotateACMEAccountKeys(ctx, log, values, nil, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rotations).To(Equal([]v1alpha1.ACMEAccountKeyRotation{{Issuer: "garden", LastRotationTime: metav1.NewTime(now)}}))
+
+		values.ComplianceProfile = &config.ComplianceProfile{Name: "strict", AllowedIssuerServers: []string{"https://acme.other.example.com/directory"}}
+		rotations, err = a.rotateACMEAccountKeys(ctx, log, values, nil, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rotations).To(BeEmpty())
+		Expect(rollovers).To(HaveLen(1))
+	})
+
+	It("should delete the new keys of pending rotations", func() {
+		rolloverFn = func(context.Context, string, *http.Client, crypto.Signer, crypto.Signer) error {
+			return errors.New("server unavailable")
+		}
+		_, err := a.rotateACMEAccountKeys(ctx, log, values, nil, now)
+		Expect(err).To(HaveOccurred())
+
+		nextSecret, err := getSecret("extension-shoot-cert-service-issuer-garden-next")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nextSecret.Labels).To(HaveKeyWithValue(shared.LabelNextACMEAccountKey, "extension-shoot-cert-service-issuer-garden"))
+
+		Expect(a.deleteNextACMEAccountKeySecrets(ctx, namespace)).To(Succeed())
+		_, err = getSecret("extension-shoot-cert-service-issuer-garden-next")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		_, err = getSecret("extension-shoot-cert-service-issuer-garden")
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
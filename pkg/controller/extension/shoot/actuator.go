@@ -6,11 +6,16 @@ package shoot
 
 import (
 	"context"
+	"crypto"
 	"fmt"
+	"net/http"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/extension"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -18,30 +23,48 @@ import (
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 	"github.com/go-logr/logr"
-	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/v1alpha1"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/validation"
+	certserviceclient "github.com/gardener/gardener-extension-shoot-cert-service/pkg/client"
 	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shared"
 )
 
+const (
+	// ConditionTypeGardenIssuerAvailable is the type of the Extension condition reporting if the issuer of the
+	// service configuration (garden issuer) is available in the shoot cluster.
+	ConditionTypeGardenIssuerAvailable gardencorev1beta1.ConditionType = "GardenIssuerAvailable"
+
+	reasonGardenIssuerDeployed = "GardenIssuerDeployed"
+	reasonGardenIssuerDisabled = "GardenIssuerDisabled"
+	reasonShootDomainMissing   = "ShootDomainMissing"
+)
+
 // NewActuator returns an actuator responsible for Extension resources.
 func NewActuator(mgr manager.Manager, config config.Configuration, extensionClasses []extensionsv1alpha1.ExtensionClass) extension.Actuator {
 	return &actuator{
-		client:            mgr.GetClient(),
-		config:            mgr.GetConfig(),
-		scheme:            mgr.GetScheme(),
-		serviceConfig:     config,
-		extensionClasses:  extensionClasses,
-		certConfigDecoder: shared.NewCertConfigDecoder(mgr),
+		client:             mgr.GetClient(),
+		config:             mgr.GetConfig(),
+		scheme:             mgr.GetScheme(),
+		serviceConfig:      config,
+		extensionClasses:   extensionClasses,
+		extensionNamespace: os.Getenv(shared.EnvLeaderElectionNamespace),
+		certConfigDecoder:  shared.NewCertConfigDecoder(mgr, config),
+		newShootAccessClient: func(ctx context.Context, namespace string) (client.Client, error) {
+			return NewShootAccessClient(ctx, mgr.GetClient(), namespace, client.Options{Scheme: certserviceclient.ClusterScheme})
+		},
+		rollOverACMEAccountKey: rollOverACMEAccountKey,
 	}
 }
 
@@ -52,8 +75,15 @@ type actuator struct {
 	scheme            *runtime.Scheme
 	decoder           runtime.Decoder
 	extensionClasses  []extensionsv1alpha1.ExtensionClass
+	// newShootAccessClient creates a client for the shoot cluster with the permissions of the shoot access secret of the extension.
+	newShootAccessClient func(ctx context.Context, namespace string) (client.Client, error)
+	// rollOverACMEAccountKey changes the key of an ACME account at the ACME server.
+	rollOverACMEAccountKey func(ctx context.Context, server string, httpClient *http.Client, oldKey, newKey crypto.Signer) error
 
 	serviceConfig config.Configuration
+	// extensionNamespace is the namespace of the extension, which contains the secrets referenced in the extension
+	// configuration without namespace.
+	extensionNamespace string
 }
 
 // Reconcile the Extension resource.
@@ -75,20 +105,103 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, ex *extension
 		return err
 	}
 
+	var defaultIssuer *v1alpha1.DefaultIssuerStatus
+	if useDefaultIssuerFallback(*values) {
+		defaultIssuer, err = a.selectDefaultIssuer(ctx, log, ex, *values, controller.IsHibernated(cluster))
+		if err != nil {
+			return err
+		}
+		values.ActiveDefaultIssuer = defaultIssuer.Name
+	}
+
+	var conditions []gardencorev1beta1.Condition
+	secretReplicationEnabled := certConfig.SecretReplication != nil && certConfig.SecretReplication.Enabled
+	trustBundleCopiesEnabled := shared.TrustBundleCopiesEnabled(certConfig)
 	if !controller.IsHibernated(cluster) {
+		// the copies are deleted first, as the permissions for them are removed with the managed resource
+		if !trustBundleCopiesEnabled {
+			if err := a.deleteTrustBundleCopies(ctx, ex, cluster); err != nil {
+				return err
+			}
+		}
 		if err := a.createShootResourcesForShoot(ctx, log, *values); err != nil {
 			return err
 		}
+		if !secretReplicationEnabled {
+			if err := a.deleteSecretReplicas(ctx, ex, cluster); err != nil {
+				return err
+			}
+		}
+		if policies := validation.PrivateKeyPolicies(&values.ExtensionConfig, values.ComplianceProfile); len(policies) > 0 {
+			conditions = append(conditions, a.checkPrivateKeyPolicy(ctx, ex, &values.ExtensionConfig, certConfig, policies))
+		}
 	}
 	if err := a.createSeedResourcesForShoot(ctx, log, *values); err != nil {
 		return err
 	}
 
-	return a.updateStatus(ctx, ex, certConfig)
+	var rotationErr error
+	rotations := previousACMEAccountKeyRotations(ex)
+	rotateACMEAccountKeys := ex.Annotations[shared.AnnotationOperation] == shared.OperationRotateACMEAccountKeys
+	if rotateACMEAccountKeys {
+		// the successful rotations are stored in the status, before the failed ones are retried with the annotation kept
+		rotations, rotationErr = a.rotateACMEAccountKeys(ctx, log, *values, rotations, time.Now())
+	}
+
+	// replicas of a disabled replication are kept until the shoot cluster is woken up
+	secretReplicationEnabled = secretReplicationEnabled || (controller.IsHibernated(cluster) && previousSecretReplicationEnabled(ex))
+	trustBundleCopiesEnabled = trustBundleCopiesEnabled || (controller.IsHibernated(cluster) && previousTrustBundleCopiesEnabled(ex))
+	if err := a.updateStatus(ctx, log, ex, certConfig, *values, defaultIssuer, rotations, secretReplicationEnabled, trustBundleCopiesEnabled, conditions...); err != nil {
+		return err
+	}
+	if rotationErr != nil {
+		return rotationErr
+	}
+	if rotateACMEAccountKeys {
+		patch := client.MergeFrom(ex.DeepCopy())
+		delete(ex.Annotations, shared.AnnotationOperation)
+		return a.client.Patch(ctx, ex, patch)
+	}
+	return nil
+}
+
+// checkPrivateKeyPolicy returns the condition reporting if the certificates of the shoot cluster comply with the
+// private key policy of the operator.
+func (a *actuator) checkPrivateKeyPolicy(ctx context.Context, ex *extensionsv1alpha1.Extension, serviceConfig *config.Configuration, certConfig *service.CertConfig,
+	policies []*config.PrivateKeyPolicy) gardencorev1beta1.Condition {
+	// the certificates are readable with the permissions of the shoot access secret
+	shootClient, err := a.newShootAccessClient(ctx, ex.Namespace)
+	if err != nil {
+		return privateKeyPolicyCondition(ex.Status.Conditions, nil, fmt.Errorf("failed to create shoot client: %w", err), serviceConfig, certConfig, policies)
+	}
+	certs, err := listShootCertificates(ctx, shootClient)
+	return privateKeyPolicyCondition(ex.Status.Conditions, certs, err, serviceConfig, certConfig, policies)
 }
 
 // Delete the Extension resource.
 func (a *actuator) Delete(ctx context.Context, log logr.Logger, ex *extensionsv1alpha1.Extension) error {
+	cluster, err := controller.GetCluster(ctx, a.client, ex.GetNamespace())
+	if err != nil {
+		return err
+	}
+	// the replicas and copies are deleted first, as the permissions of the shoot access secret are removed with the managed resources
+	if err := a.deleteSecretReplicas(ctx, ex, cluster); err != nil {
+		return err
+	}
+	if err := a.deleteTrustBundleCopies(ctx, ex, cluster); err != nil {
+		return err
+	}
+
+	if err := a.delete(ctx, log, ex); err != nil {
+		return err
+	}
+	if err := a.deleteNextACMEAccountKeySecrets(ctx, ex.GetNamespace()); err != nil {
+		return err
+	}
+	return a.deleteClusterCA(ctx, log, cluster)
+}
+
+func (a *actuator) delete(ctx context.Context, log logr.Logger, ex *extensionsv1alpha1.Extension) error {
 	namespace := ex.GetNamespace()
 
 	log.Info("Component is being deleted", "component", "cert-management", "namespace", namespace)
@@ -129,7 +242,9 @@ func (a *actuator) Migrate(ctx context.Context, log logr.Logger, ex *extensionsv
 		return err
 	}
 
-	return a.Delete(ctx, log, ex)
+	// The persisted cluster CA secrets and the new keys of pending ACME account key rotations are kept,
+	// they are restored from the shoot state on the destination seed.
+	return a.delete(ctx, log, ex)
 }
 
 func (a *actuator) createValues(
@@ -148,6 +263,18 @@ func (a *actuator) createValues(
 		Resources:       nil,
 		ShootDeployment: true,
 		Replicas:        1,
+
+		ExtensionNamespace: a.extensionNamespace,
+	}
+	values.ExtensionConfig, values.ConfigurationOverrides = shared.EffectiveConfiguration(a.serviceConfig, cluster.Seed)
+	if len(values.ConfigurationOverrides) > 0 {
+		log.Info("Applied configuration overrides", "overrides", values.ConfigurationOverrides)
+	}
+	if values.ExtensionConfig.Proxy != nil {
+		values.SeedKubeAPIServerHost, err = shared.SeedKubeAPIServerHost(ctx, a.client)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	values.NextGenDNSShootService, err = a.isNextGenDNSShootServiceEnabled(ctx, namespace)
@@ -156,12 +283,17 @@ func (a *actuator) createValues(
 	}
 
 	values.Replicas = int32(controller.GetReplicas(cluster, 1)) // #nosec G115 -- replicas are always small integers
-	if values.RestrictedIssuer() {
-		if cluster.Shoot.Spec.DNS == nil || cluster.Shoot.Spec.DNS.Domain == nil {
-			log.Info("no domain given for shoot %s/%s - aborting", cluster.Shoot.Name, cluster.Shoot.Namespace)
-			return nil, nil
+	if dns := cluster.Shoot.Spec.DNS; dns != nil && dns.Domain != nil {
+		values.ShootDomain = *dns.Domain
+	}
+	values.ComplianceProfile = validation.ComplianceProfile(&values.ExtensionConfig, cluster, certConfig)
+	if ptr.Deref(values.ExtensionConfig.RestrictIssuer, false) {
+		if values.ShootDomain == "" {
+			log.Info("No domain given for shoot, the restricted default issuer is not deployed", "shoot", client.ObjectKeyFromObject(cluster.Shoot))
+			values.MissingShootDomain = true
+		} else {
+			values.RestrictedDomains = strings.Join(validation.RestrictedDomains(&values.ExtensionConfig, cluster), ",")
 		}
-		values.RestrictedDomains = *cluster.Shoot.Spec.DNS.Domain
 	}
 
 	if err := gardenerutils.NewShootAccessSecret(v1alpha1.ShootAccessSecretName, namespace).Reconcile(ctx, a.client); err != nil {
@@ -170,6 +302,11 @@ func (a *actuator) createValues(
 	values.GenericTokenKubeconfigSecretName = extensions.GenericTokenKubeconfigSecretNameFromCluster(cluster)
 	values.Resources = cluster.Shoot.Spec.Resources
 
+	values.ClusterCAIssuer, values.ClusterCABundle, err = a.reconcileClusterCA(ctx, log, values.ExtensionConfig, certConfig, cluster)
+	if err != nil {
+		return nil, err
+	}
+
 	values.Image, err = shared.PrepareCertManagementImage()
 	if err != nil {
 		return nil, err
@@ -203,34 +340,67 @@ func (a *actuator) dropShootResourcesForShoot(ctx context.Context, log logr.Logg
 	return shared.NewDeployer(shared.Values{Namespace: namespace, ShootDeployment: true}).DropShootManagedResource(ctx, a.client)
 }
 
-func (a *actuator) updateStatus(ctx context.Context, ex *extensionsv1alpha1.Extension, certConfig *service.CertConfig) error {
-	var resources []gardencorev1beta1.NamedResourceReference
-	for _, issuerConfig := range certConfig.Issuers {
-		name := "extension-shoot-cert-service-issuer-" + issuerConfig.Name
-		resources = append(resources, gardencorev1beta1.NamedResourceReference{
-			Name: name,
-			ResourceRef: autoscalingv1.CrossVersionObjectReference{
-				Kind:       "Secret",
-				Name:       name,
-				APIVersion: "v1",
-			},
-		})
+func (a *actuator) updateStatus(ctx context.Context, log logr.Logger, ex *extensionsv1alpha1.Extension, certConfig *service.CertConfig, values shared.Values,
+	defaultIssuer *v1alpha1.DefaultIssuerStatus, rotations []v1alpha1.ACMEAccountKeyRotation, secretReplicationEnabled, trustBundleCopiesEnabled bool, conditions ...gardencorev1beta1.Condition) error {
+	// the exported resources are only needed for a migration, so the status is updated anyway
+	resources, err := a.acmeAccountSecretResources(ctx, values)
+	if err != nil {
+		log.Error(err, "Failed to determine the ACME account secrets to export, keeping the previous resources")
+		resources = ex.Status.Resources
+	}
+
+	status := &v1alpha1.CertStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       "CertStatus",
+		},
+		ShootIssuers: &v1alpha1.ShootIssuers{
+			Enabled: shared.ShootIssuersEnabled(values.ExtensionConfig, *certConfig, values.ComplianceProfile),
+		},
+		DefaultIssuer:            defaultIssuer,
+		EffectiveConfiguration:   effectiveConfiguration(values),
+		ACMEAccountKeyRotations:  rotations,
+		SecretReplicationEnabled: secretReplicationEnabled,
+		TrustBundleCopiesEnabled: trustBundleCopiesEnabled,
 	}
 
 	patch := client.MergeFrom(ex.DeepCopy())
 	ex.Status.Resources = resources
+	ex.Status.ProviderStatus = &runtime.RawExtension{Object: status}
+	ex.Status.Conditions = v1beta1helper.MergeConditions(ex.Status.Conditions, append(conditions, gardenIssuerCondition(ex.Status.Conditions, certConfig, values))...)
+	if len(validation.PrivateKeyPolicies(&values.ExtensionConfig, values.ComplianceProfile)) == 0 {
+		ex.Status.Conditions = v1beta1helper.RemoveConditions(ex.Status.Conditions, ConditionTypePrivateKeyPolicyCompliant)
+	}
 	return a.client.Status().Patch(ctx, ex, patch)
 }
 
-func (a *actuator) createShootIssuersValues(certConfig *service.CertConfig) map[string]any {
-	shootIssuersEnabled := false
-	if certConfig.ShootIssuers != nil {
-		shootIssuersEnabled = certConfig.ShootIssuers.Enabled
-	} else if a.serviceConfig.ShootIssuers != nil {
-		shootIssuersEnabled = a.serviceConfig.ShootIssuers.Enabled
+// effectiveConfiguration returns the settings of the service configuration effective for the shoot cluster.
+func effectiveConfiguration(values shared.Values) *v1alpha1.EffectiveConfiguration {
+	effective := &v1alpha1.EffectiveConfiguration{
+		Overrides:    values.ConfigurationOverrides,
+		ProxyEnabled: values.ExtensionConfig.Proxy != nil && (values.ExtensionConfig.Proxy.HTTPProxy != nil || values.ExtensionConfig.Proxy.HTTPSProxy != nil),
 	}
-	return map[string]any{
-		"enabled": shootIssuersEnabled,
+	if acme := values.ExtensionConfig.ACME; acme != nil {
+		effective.ACMEServer = &acme.Server
+		effective.PrecheckNameservers = acme.PrecheckNameservers
+	}
+	return effective
+}
+
+// gardenIssuerCondition returns the condition reporting if the issuer of the service configuration is deployed for the shoot cluster.
+func gardenIssuerCondition(conditions []gardencorev1beta1.Condition, certConfig *service.CertConfig, values shared.Values) gardencorev1beta1.Condition {
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock.RealClock{}, conditions, ConditionTypeGardenIssuerAvailable)
+	switch {
+	case values.MissingShootDomain:
+		return v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, gardencorev1beta1.ConditionFalse, reasonShootDomainMissing,
+			"The default issuer is restricted to the shoot domain, but the shoot has no DNS domain (spec.dns.domain). "+
+				"Certificates can only be requested with custom issuers until a domain is set.")
+	case certConfig.DefaultIssuer != nil && certConfig.DefaultIssuer.DisableGardenIssuer:
+		return v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, gardencorev1beta1.ConditionFalse, reasonGardenIssuerDisabled,
+			"The issuer provided by Gardener is disabled in the shoot manifest.")
+	default:
+		return v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, gardencorev1beta1.ConditionTrue, reasonGardenIssuerDeployed,
+			"The issuer provided by Gardener is deployed.")
 	}
 }
 
@@ -7,11 +7,17 @@ package shoot
 import (
 	"context"
 	"fmt"
+	"os"
 	"slices"
+	"time"
 
+	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/extension"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/extensions"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -77,7 +83,36 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 			handler.TypedEnqueueRequestsFromMapFunc(mapDNSServiceExtensionToCertServiceExtension()),
 			&dnsServiceExtensionPredicate{},
 		))
-	})
+	}, func(c controller.Controller) error {
+		return c.Watch(source.Kind(
+			mgr.GetCache(),
+			&extensionsv1alpha1.Extension{},
+			&handler.TypedEnqueueRequestForObject[*extensionsv1alpha1.Extension]{},
+			&operationAnnotationPredicate{},
+		))
+	}, func(c controller.Controller) error {
+		return c.Watch(source.Kind(
+			mgr.GetCache(),
+			&extensionsv1alpha1.Cluster{},
+			handler.TypedEnqueueRequestsFromMapFunc(mapClusterToCertServiceExtension()),
+			&shootDomainPredicate{},
+		))
+	}, func(c controller.Controller) error {
+		fallback := opts.ServiceConfig.DefaultIssuerFallback
+		if fallback == nil {
+			return nil
+		}
+		var minHoldDuration time.Duration
+		if fallback.MinHoldDuration != nil {
+			minHoldDuration = fallback.MinHoldDuration.Duration
+		}
+		return c.Watch(source.Kind(
+			mgr.GetCache(),
+			&certv1alpha1.Issuer{},
+			enqueueForDefaultIssuerCandidate(minHoldDuration),
+			&defaultIssuerCandidatePredicate{issuerName: opts.ServiceConfig.IssuerName},
+		))
+	}, shared.WatchReferencedSecrets(mgr, opts.ServiceConfig, os.Getenv(shared.EnvLeaderElectionNamespace), Type))
 
 	return extension.Add(mgr, extension.AddArgs{
 		Actuator:          NewActuator(mgr, opts.ServiceConfig, extensionClasses),
@@ -134,3 +169,116 @@ func (dnsServiceExtensionPredicate) Delete(_ event.TypedDeleteEvent[*extensionsv
 func (dnsServiceExtensionPredicate) Generic(_ event.TypedGenericEvent[*extensionsv1alpha1.Extension]) bool {
 	return false
 }
+
+// operationAnnotationPredicate filters Extension events to only updates of shoot-cert-service Extensions setting the
+// operation annotation of the shoot cert service. The operation is performed by the next reconciliation.
+type operationAnnotationPredicate struct{}
+
+func (operationAnnotationPredicate) Create(_ event.TypedCreateEvent[*extensionsv1alpha1.Extension]) bool {
+	return false
+}
+
+func (operationAnnotationPredicate) Update(e event.TypedUpdateEvent[*extensionsv1alpha1.Extension]) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil || e.ObjectNew.Spec.Type != Type {
+		return false
+	}
+	operation := e.ObjectNew.Annotations[shared.AnnotationOperation]
+	return operation != "" && operation != e.ObjectOld.Annotations[shared.AnnotationOperation]
+}
+
+func (operationAnnotationPredicate) Delete(_ event.TypedDeleteEvent[*extensionsv1alpha1.Extension]) bool {
+	return false
+}
+
+func (operationAnnotationPredicate) Generic(_ event.TypedGenericEvent[*extensionsv1alpha1.Extension]) bool {
+	return false
+}
+
+// mapClusterToCertServiceExtension maps a Cluster event to a reconcile request for the shoot-cert-service Extension
+// in the namespace of the cluster.
+func mapClusterToCertServiceExtension() func(context.Context, *extensionsv1alpha1.Cluster) []reconcile.Request {
+	return func(_ context.Context, cluster *extensionsv1alpha1.Cluster) []reconcile.Request {
+		if cluster == nil {
+			return nil
+		}
+		return []reconcile.Request{{
+			NamespacedName: client.ObjectKey{
+				Name:      Type,
+				Namespace: cluster.Name,
+			},
+		}}
+	}
+}
+
+// shootDomainPredicate filters Cluster events to only updates setting the DNS domain of the shoot.
+// Shoots without DNS domain are reconciled without the restricted default issuer, which is deployed once the domain is set.
+type shootDomainPredicate struct{}
+
+func (shootDomainPredicate) Create(_ event.TypedCreateEvent[*extensionsv1alpha1.Cluster]) bool {
+	return false
+}
+
+func (shootDomainPredicate) Update(e event.TypedUpdateEvent[*extensionsv1alpha1.Cluster]) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return false
+	}
+	return shootDomainFromCluster(e.ObjectOld) == "" && shootDomainFromCluster(e.ObjectNew) != ""
+}
+
+func (shootDomainPredicate) Delete(_ event.TypedDeleteEvent[*extensionsv1alpha1.Cluster]) bool {
+	return false
+}
+
+func (shootDomainPredicate) Generic(_ event.TypedGenericEvent[*extensionsv1alpha1.Cluster]) bool {
+	return false
+}
+
+func shootDomainFromCluster(cluster *extensionsv1alpha1.Cluster) string {
+	shoot, err := extensions.ShootFromCluster(cluster)
+	if err != nil || shoot == nil || shoot.Spec.DNS == nil {
+		return ""
+	}
+	return ptr.Deref(shoot.Spec.DNS.Domain, "")
+}
+
+// defaultIssuerCandidatePredicate filters Issuer events to only state changes of the issuer of the service configuration
+// and its fallback issuers in the shoot namespaces, which may change the selected default issuer.
+type defaultIssuerCandidatePredicate struct {
+	issuerName string
+}
+
+func (defaultIssuerCandidatePredicate) Create(_ event.TypedCreateEvent[*certv1alpha1.Issuer]) bool {
+	return false
+}
+
+func (p defaultIssuerCandidatePredicate) Update(e event.TypedUpdateEvent[*certv1alpha1.Issuer]) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return false
+	}
+	if e.ObjectNew.Name != p.issuerName && e.ObjectNew.Labels[shared.LabelFallbackIssuer] != "true" {
+		return false
+	}
+	return e.ObjectOld.Status.State != e.ObjectNew.Status.State
+}
+
+func (defaultIssuerCandidatePredicate) Delete(_ event.TypedDeleteEvent[*certv1alpha1.Issuer]) bool {
+	return false
+}
+
+func (defaultIssuerCandidatePredicate) Generic(_ event.TypedGenericEvent[*certv1alpha1.Issuer]) bool {
+	return false
+}
+
+// enqueueForDefaultIssuerCandidate enqueues the shoot-cert-service Extension in the namespace of the issuer. As a fallback
+// issuer is kept for the minimum hold duration, the Extension is enqueued again after it has passed at the latest.
+func enqueueForDefaultIssuerCandidate(minHoldDuration time.Duration) handler.TypedEventHandler[*certv1alpha1.Issuer, reconcile.Request] {
+	return handler.TypedFuncs[*certv1alpha1.Issuer, reconcile.Request]{
+		UpdateFunc: func(_ context.Context, e event.TypedUpdateEvent[*certv1alpha1.Issuer], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			request := reconcile.Request{NamespacedName: client.ObjectKey{Name: Type, Namespace: e.ObjectNew.Namespace}}
+			q.Add(request)
+			if minHoldDuration > 0 {
+				q.AddAfter(request, minHoldDuration)
+			}
+		},
+	}
+}
@@ -6,17 +6,25 @@ package shoot
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
+	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shared"
 )
 
 var _ = Describe("dnsServiceExtensionPredicate", func() {
@@ -165,6 +173,150 @@ var _ = Describe("mapDNSServiceExtensionToCertServiceExtension", func() {
 	})
 })
 
+var _ = Describe("shootDomainPredicate", func() {
+	var (
+		predicate shootDomainPredicate
+
+		makeCluster = func(domain *string) *extensionsv1alpha1.Cluster {
+			shoot := &gardencorev1beta1.Shoot{
+				TypeMeta: metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "Shoot"},
+				Spec:     gardencorev1beta1.ShootSpec{DNS: &gardencorev1beta1.DNS{Domain: domain}},
+			}
+			raw, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+			return &extensionsv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar"},
+				Spec:       extensionsv1alpha1.ClusterSpec{Shoot: runtime.RawExtension{Raw: raw}},
+			}
+		}
+	)
+
+	It("should accept when the shoot domain is set", func() {
+		Expect(predicate.Update(event.TypedUpdateEvent[*extensionsv1alpha1.Cluster]{
+			ObjectOld: makeCluster(nil),
+			ObjectNew: makeCluster(ptr.To("foo.example.com")),
+		})).To(BeTrue())
+	})
+
+	It("should reject when the shoot domain is unchanged", func() {
+		Expect(predicate.Update(event.TypedUpdateEvent[*extensionsv1alpha1.Cluster]{
+			ObjectOld: makeCluster(ptr.To("foo.example.com")),
+			ObjectNew: makeCluster(ptr.To("foo.example.com")),
+		})).To(BeFalse())
+	})
+
+	It("should reject when the shoot domain is still missing", func() {
+		Expect(predicate.Update(event.TypedUpdateEvent[*extensionsv1alpha1.Cluster]{
+			ObjectOld: makeCluster(nil),
+			ObjectNew: makeCluster(nil),
+		})).To(BeFalse())
+	})
+
+	It("should reject create, delete and generic events", func() {
+		Expect(predicate.Create(event.TypedCreateEvent[*extensionsv1alpha1.Cluster]{Object: makeCluster(nil)})).To(BeFalse())
+		Expect(predicate.Delete(event.TypedDeleteEvent[*extensionsv1alpha1.Cluster]{Object: makeCluster(nil)})).To(BeFalse())
+		Expect(predicate.Generic(event.TypedGenericEvent[*extensionsv1alpha1.Cluster]{Object: makeCluster(nil)})).To(BeFalse())
+	})
+
+	It("should map the cluster to the shoot-cert-service Extension in the namespace of the cluster", func() {
+		Expect(mapClusterToCertServiceExtension()(context.Background(), makeCluster(nil))).To(Equal([]reconcile.Request{{
+			NamespacedName: client.ObjectKey{
+				Name:      Type,
+				Namespace: "shoot--foo--bar",
+			},
+		}}))
+	})
+})
+
+var _ = Describe("operationAnnotationPredicate", func() {
+	var (
+		predicate operationAnnotationPredicate
+
+		makeExtension = func(extensionType string, annotations map[string]string) *extensionsv1alpha1.Extension {
+			return &extensionsv1alpha1.Extension{
+				ObjectMeta: metav1.ObjectMeta{Name: extensionType, Namespace: "shoot--foo--bar", Annotations: annotations},
+				Spec:       extensionsv1alpha1.ExtensionSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: extensionType}},
+			}
+		}
+		rotate = map[string]string{shared.AnnotationOperation: shared.OperationRotateACMEAccountKeys}
+	)
+
+	It("should accept when the operation annotation is added", func() {
+		Expect(predicate.Update(event.TypedUpdateEvent[*extensionsv1alpha1.Extension]{
+			ObjectOld: makeExtension(Type, nil),
+			ObjectNew: makeExtension(Type, rotate),
+		})).To(BeTrue())
+	})
+
+	It("should reject when the operation annotation is unchanged or removed", func() {
+		Expect(predicate.Update(event.TypedUpdateEvent[*extensionsv1alpha1.Extension]{
+			ObjectOld: makeExtension(Type, rotate),
+			ObjectNew: makeExtension(Type, rotate),
+		})).To(BeFalse())
+		Expect(predicate.Update(event.TypedUpdateEvent[*extensionsv1alpha1.Extension]{
+			ObjectOld: makeExtension(Type, rotate),
+			ObjectNew: makeExtension(Type, nil),
+		})).To(BeFalse())
+	})
+
+	It("should reject Extensions of other types", func() {
+		Expect(predicate.Update(event.TypedUpdateEvent[*extensionsv1alpha1.Extension]{
+			ObjectOld: makeExtension("shoot-dns-service", nil),
+			ObjectNew: makeExtension("shoot-dns-service", rotate),
+		})).To(BeFalse())
+	})
+})
+
+var _ = Describe("defaultIssuerCandidatePredicate", func() {
+	var (
+		predicate = defaultIssuerCandidatePredicate{issuerName: "garden"}
+
+		makeIssuer = func(name string, labels map[string]string, state string) *certv1alpha1.Issuer {
+			return &certv1alpha1.Issuer{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shoot--foo--bar", Labels: labels},
+				Status:     certv1alpha1.IssuerStatus{State: state},
+			}
+		}
+		fallback = map[string]string{shared.LabelFallbackIssuer: "true"}
+	)
+
+	It("should accept state changes of the garden issuer and the fallback issuers", func() {
+		Expect(predicate.Update(event.TypedUpdateEvent[*certv1alpha1.Issuer]{
+			ObjectOld: makeIssuer("garden", nil, certv1alpha1.StateReady),
+			ObjectNew: makeIssuer("garden", nil, certv1alpha1.StateError),
+		})).To(BeTrue())
+		Expect(predicate.Update(event.TypedUpdateEvent[*certv1alpha1.Issuer]{
+			ObjectOld: makeIssuer("zerossl", fallback, ""),
+			ObjectNew: makeIssuer("zerossl", fallback, certv1alpha1.StateReady),
+		})).To(BeTrue())
+	})
+
+	It("should reject unchanged states and other issuers", func() {
+		Expect(predicate.Update(event.TypedUpdateEvent[*certv1alpha1.Issuer]{
+			ObjectOld: makeIssuer("garden", nil, certv1alpha1.StateReady),
+			ObjectNew: makeIssuer("garden", nil, certv1alpha1.StateReady),
+		})).To(BeFalse())
+		Expect(predicate.Update(event.TypedUpdateEvent[*certv1alpha1.Issuer]{
+			ObjectOld: makeIssuer("custom", nil, certv1alpha1.StateReady),
+			ObjectNew: makeIssuer("custom", nil, certv1alpha1.StateError),
+		})).To(BeFalse())
+	})
+
+	It("should enqueue the shoot-cert-service Extension in the namespace of the issuer", func() {
+		queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+		defer queue.ShutDown()
+
+		enqueueForDefaultIssuerCandidate(time.Hour).Update(context.Background(), event.TypedUpdateEvent[*certv1alpha1.Issuer]{
+			ObjectOld: makeIssuer("garden", nil, certv1alpha1.StateReady),
+			ObjectNew: makeIssuer("garden", nil, certv1alpha1.StateError),
+		}, queue)
+
+		Expect(queue.Len()).To(Equal(1))
+		request, _ := queue.Get()
+		Expect(request).To(Equal(reconcile.Request{NamespacedName: client.ObjectKey{Name: Type, Namespace: "shoot--foo--bar"}}))
+	})
+})
+
 var _ = Describe("isNextGenDNSShootServiceEnabled", func() {
 	const namespace = "shoot--foo--bar"
 
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	extensionssecretsmanager "github.com/gardener/gardener/extensions/pkg/util/secret/manager"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	"github.com/go-logr/logr"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shared"
+)
+
+const (
+	// secretsManagerIdentity is the identity used for the secrets manager.
+	secretsManagerIdentity = "extension-shoot-cert-service"
+	// clusterCASecretName is the name of the secret containing the generated per-shoot root CA.
+	clusterCASecretName = "ca-extension-shoot-cert-service-cluster"
+)
+
+func clusterCASecretConfigs() []extensionssecretsmanager.SecretConfigWithOptions {
+	return []extensionssecretsmanager.SecretConfigWithOptions{
+		{
+			Config: &secretsutils.CertificateSecretConfig{
+				Name:       clusterCASecretName,
+				CommonName: clusterCASecretName,
+				CertType:   secretsutils.CACert,
+			},
+			Options: []secretsmanager.GenerateOption{secretsmanager.Persist()},
+		},
+	}
+}
+
+// reconcileClusterCA generates the per-shoot root CA if the cluster CA issuer is enabled and returns the issuer model
+// and the CA bundle. The CA is rotated in lockstep with the shoot cluster CAs. During rotation, the CA bundle
+// contains both the old and the new CA certificate. If the cluster CA issuer is disabled, the CA secrets are removed.
+// The name of the issuer must not conflict with the default issuer of the given effective extension configuration.
+func (a *actuator) reconcileClusterCA(ctx context.Context, log logr.Logger, extensionConfig config.Configuration, certConfig *service.CertConfig, cluster *controller.Cluster) (*shared.Issuer, string, error) {
+	var (
+		name          string
+		secretConfigs []extensionssecretsmanager.SecretConfigWithOptions
+	)
+	if certConfig.ClusterCAIssuer != nil && certConfig.ClusterCAIssuer.Enabled {
+		name = ptr.Deref(certConfig.ClusterCAIssuer.Name, service.DefaultClusterCAIssuerName)
+		if name == extensionConfig.IssuerName {
+			return nil, "", fmt.Errorf("name of cluster CA issuer %q conflicts with the default issuer", name)
+		}
+		secretConfigs = clusterCASecretConfigs()
+	}
+
+	sm, err := extensionssecretsmanager.SecretsManagerForCluster(ctx, log.WithName("secretsmanager"), clock.RealClock{}, a.client, cluster, secretsManagerIdentity, secretConfigs)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create secrets manager: %w", err)
+	}
+
+	secrets, err := extensionssecretsmanager.GenerateAllSecrets(ctx, sm, secretConfigs)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate cluster CA: %w", err)
+	}
+
+	if err := sm.Cleanup(ctx); err != nil {
+		return nil, "", fmt.Errorf("failed to cleanup secrets: %w", err)
+	}
+
+	caSecret, ok := secrets[clusterCASecretName]
+	if !ok {
+		return nil, "", nil
+	}
+
+	var bundle string
+	if bundleSecret, ok := sm.Get(clusterCASecretName, secretsmanager.Bundle); ok {
+		bundle = string(bundleSecret.Data[secretsutils.DataKeyCertificateBundle])
+	}
+
+	return &shared.Issuer{
+		Name: name,
+		CA: &shared.CA{
+			Certificate:    string(caSecret.Data[secretsutils.DataKeyCertificateCA]),
+			CertificateKey: string(caSecret.Data[secretsutils.DataKeyPrivateKeyCA]),
+		},
+	}, bundle, nil
+}
+
+// deleteClusterCA removes all secrets managed by the secrets manager.
+func (a *actuator) deleteClusterCA(ctx context.Context, log logr.Logger, cluster *controller.Cluster) error {
+	sm, err := extensionssecretsmanager.SecretsManagerForCluster(ctx, log.WithName("secretsmanager"), clock.RealClock{}, a.client, cluster, secretsManagerIdentity, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create secrets manager: %w", err)
+	}
+	return sm.Cleanup(ctx)
+}
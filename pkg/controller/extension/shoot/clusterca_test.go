@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
+)
+
+var _ = Describe("ClusterCA", func() {
+	var (
+		ctx     = context.Background()
+		log     = logr.Discard()
+		c       client.Client
+		a       *actuator
+		cluster *controller.Cluster
+
+		extensionConfig config.Configuration
+
+		listSecrets = func() []corev1.Secret {
+			secrets := &corev1.SecretList{}
+			Expect(c.List(ctx, secrets, client.InNamespace("shoot--foo--bar"), client.MatchingLabels{"name": clusterCASecretName})).To(Succeed())
+			return secrets.Items
+		}
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+		a = &actuator{client: c}
+		extensionConfig = config.Configuration{IssuerName: "garden"}
+		cluster = &controller.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar"},
+			Shoot:      &gardencorev1beta1.Shoot{},
+		}
+	})
+
+	It("should not create a CA if not enabled", func() {
+		issuer, _, err := a.reconcileClusterCA(ctx, log, extensionConfig, &service.CertConfig{}, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issuer).To(BeNil())
+		Expect(listSecrets()).To(BeEmpty())
+	})
+
+	It("should create a persisted CA and keep it stable", func() {
+		certConfig := &service.CertConfig{ClusterCAIssuer: &service.ClusterCAIssuer{Enabled: true}}
+		issuer, bundle, err := a.reconcileClusterCA(ctx, log, extensionConfig, certConfig, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issuer).NotTo(BeNil())
+		Expect(issuer.Name).To(Equal("cluster-ca"))
+		Expect(issuer.CA.Certificate).To(HavePrefix("-----BEGIN CERTIFICATE-----"))
+		Expect(issuer.CA.CertificateKey).NotTo(BeEmpty())
+		Expect(bundle).To(Equal(issuer.CA.Certificate))
+
+		secrets := listSecrets()
+		Expect(secrets).To(HaveLen(1))
+		Expect(secrets[0].Labels).To(HaveKeyWithValue("persist", "true"))
+
+		issuer2, _, err := a.reconcileClusterCA(ctx, log, extensionConfig, certConfig, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issuer2).To(Equal(issuer))
+	})
+
+	It("should use the configured issuer name", func() {
+		certConfig := &service.CertConfig{ClusterCAIssuer: &service.ClusterCAIssuer{Enabled: true, Name: new("internal")}}
+		issuer, _, err := a.reconcileClusterCA(ctx, log, extensionConfig, certConfig, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issuer.Name).To(Equal("internal"))
+	})
+
+	It("should fail if the name conflicts with the default issuer", func() {
+		certConfig := &service.CertConfig{ClusterCAIssuer: &service.ClusterCAIssuer{Enabled: true, Name: new("garden")}}
+		_, _, err := a.reconcileClusterCA(ctx, log, extensionConfig, certConfig, cluster)
+		Expect(err).To(MatchError(ContainSubstring("conflicts with the default issuer")))
+		Expect(listSecrets()).To(BeEmpty())
+
+		extensionConfig.IssuerName = "garden-eu"
+		_, _, err = a.reconcileClusterCA(ctx, log, extensionConfig, certConfig, cluster)
+		Expect(err).NotTo(HaveOccurred(), "the effective configuration is checked")
+
+		certConfig.ClusterCAIssuer.Name = new("garden-eu")
+		_, _, err = a.reconcileClusterCA(ctx, log, extensionConfig, certConfig, cluster)
+		Expect(err).To(MatchError(ContainSubstring("conflicts with the default issuer")))
+	})
+
+	It("should remove the CA if disabled or deleted", func() {
+		_, _, err := a.reconcileClusterCA(ctx, log, extensionConfig, &service.CertConfig{ClusterCAIssuer: &service.ClusterCAIssuer{Enabled: true}}, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listSecrets()).To(HaveLen(1))
+
+		issuer, _, err := a.reconcileClusterCA(ctx, log, extensionConfig, &service.CertConfig{ClusterCAIssuer: &service.ClusterCAIssuer{Enabled: false}}, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issuer).To(BeNil())
+		Expect(listSecrets()).To(BeEmpty())
+
+		_, _, err = a.reconcileClusterCA(ctx, log, extensionConfig, &service.CertConfig{ClusterCAIssuer: &service.ClusterCAIssuer{Enabled: true}}, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(a.deleteClusterCA(ctx, log, cluster)).To(Succeed())
+		Expect(listSecrets()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/v1alpha1"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shared"
+)
+
+// rateLimitMessages are the message parts of certificates which could not be requested because of rate limits of the
+// ACME server or because the request quota of the issuer is exhausted.
+var rateLimitMessages = []string{
+	"urn:ietf:params:acme:error:rateLimited",
+	"request quota exhausted",
+}
+
+// useDefaultIssuerFallback checks if the default issuer of the shoot is selected from the issuer of the service
+// configuration and its fallback issuers.
+func useDefaultIssuerFallback(values shared.Values) bool {
+	return values.FallbackIssuersEnabled() &&
+		shared.DefaultIssuerName(values.ExtensionConfig, values.CertConfig) == values.ExtensionConfig.IssuerName
+}
+
+// selectDefaultIssuer selects the default issuer from the issuer of the service configuration and its fallback issuers
+// based on the state of the issuers in the shoot namespace and the certificates of the shoot cluster.
+// If the certificates of the shoot cluster cannot be read, the selection is only based on the state of the issuers.
+func (a *actuator) selectDefaultIssuer(ctx context.Context, log logr.Logger, ex *extensionsv1alpha1.Extension, values shared.Values, hibernated bool) (*v1alpha1.DefaultIssuerStatus, error) {
+	issuerList := &certv1alpha1.IssuerList{}
+	if err := a.client.List(ctx, issuerList, client.InNamespace(ex.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list issuers: %w", err)
+	}
+
+	var certs []certv1alpha1.Certificate
+	if !hibernated {
+		shootClient, err := a.newShootAccessClient(ctx, ex.Namespace)
+		if err == nil {
+			certs, err = listShootCertificates(ctx, shootClient)
+		}
+		if err != nil {
+			// the reconciliation of the extension must not be blocked by an unreachable shoot cluster
+			log.Error(err, "Failed to read the certificates of the shoot cluster, selecting the default issuer by the issuer states only")
+		}
+	}
+
+	candidates := []string{values.ExtensionConfig.IssuerName}
+	for _, fallback := range values.ExtensionConfig.DefaultIssuerFallback.Issuers {
+		candidates = append(candidates, fallback.Name)
+	}
+	var minHoldDuration time.Duration
+	if d := values.ExtensionConfig.DefaultIssuerFallback.MinHoldDuration; d != nil {
+		minHoldDuration = d.Duration
+	}
+
+	status := defaultIssuerStatus(previousDefaultIssuerStatus(ex), candidates, issuerList.Items, certs, minHoldDuration, time.Now())
+	log.Info("Selected default issuer", "issuer", status.Name, "fallback", status.Fallback, "message", status.Message)
+	return status, nil
+}
+
+// defaultIssuerStatus returns the status of the default issuer. The first candidate which is ready and not rate limited
+// is selected. A less preferred issuer is kept for the minimum hold duration before switching back to a preferred
+// issuer to avoid flapping, as the certificates of a rate limited issuer are retried with the selected issuer.
+func defaultIssuerStatus(previous *v1alpha1.DefaultIssuerStatus, candidates []string, issuers []certv1alpha1.Issuer, certs []certv1alpha1.Certificate,
+	minHoldDuration time.Duration, now time.Time) *v1alpha1.DefaultIssuerStatus {
+	var (
+		selected    = -1
+		unavailable []string
+	)
+	for i, name := range candidates {
+		if reason := issuerUnavailableReason(name, issuers, certs); reason != "" {
+			unavailable = append(unavailable, fmt.Sprintf("%s is %s", name, reason))
+			continue
+		}
+		selected = i
+		break
+	}
+
+	var message string
+	switch {
+	case selected == -1:
+		// no issuer is available, the issuer of the service configuration stays the default issuer
+		selected = 0
+		message = "No default issuer is available: " + strings.Join(unavailable, ", ")
+	case selected == 0:
+		message = "The issuer provided by Gardener is available."
+	default:
+		message = "Using fallback issuer: " + strings.Join(unavailable, ", ")
+	}
+
+	if previous != nil && previous.Name != candidates[selected] {
+		if prevIndex := slices.Index(candidates, previous.Name); prevIndex > selected &&
+			issuerUnavailableReason(previous.Name, issuers, certs) == "" &&
+			now.Sub(previous.LastTransitionTime.Time) < minHoldDuration {
+			status := previous.DeepCopy()
+			status.Message = fmt.Sprintf("Keeping fallback issuer for the minimum hold duration of %s, although %s is available again.",
+				minHoldDuration, candidates[selected])
+			return status
+		}
+	}
+
+	status := &v1alpha1.DefaultIssuerStatus{
+		Name:               candidates[selected],
+		Fallback:           selected > 0,
+		Message:            message,
+		LastTransitionTime: metav1.NewTime(now),
+	}
+	if previous != nil && previous.Name == status.Name {
+		status.LastTransitionTime = previous.LastTransitionTime
+	}
+	return status
+}
+
+// issuerUnavailableReason returns why the issuer with the given name cannot be used as default issuer or an empty
+// string if it is available.
+func issuerUnavailableReason(name string, issuers []certv1alpha1.Issuer, certs []certv1alpha1.Certificate) string {
+	idx := slices.IndexFunc(issuers, func(issuer certv1alpha1.Issuer) bool { return issuer.Name == name })
+	if idx == -1 {
+		return "not deployed"
+	}
+	if state := issuers[idx].Status.State; state != certv1alpha1.StateReady {
+		return fmt.Sprintf("not ready (state '%s')", state)
+	}
+	if count := rateLimitedCertificates(name, certs); count > 0 {
+		return fmt.Sprintf("rate limited (%d certificate(s))", count)
+	}
+	return ""
+}
+
+// rateLimitedCertificates counts the certificates which could not be requested from the issuer with the given name
+// because of rate limits.
+func rateLimitedCertificates(issuerName string, certs []certv1alpha1.Certificate) int {
+	count := 0
+	for _, cert := range certs {
+		ref := cert.Status.IssuerRef
+		if ref == nil || ref.Name != issuerName || ref.Cluster == "target" ||
+			cert.Status.State == certv1alpha1.StateReady || cert.Status.Message == nil {
+			continue
+		}
+		if slices.ContainsFunc(rateLimitMessages, func(msg string) bool { return strings.Contains(*cert.Status.Message, msg) }) {
+			count++
+		}
+	}
+	return count
+}
+
+// previousDefaultIssuerStatus returns the status of the default issuer from the provider status of the Extension resource.
+func previousDefaultIssuerStatus(ex *extensionsv1alpha1.Extension) *v1alpha1.DefaultIssuerStatus {
+	if ex.Status.ProviderStatus == nil {
+		return nil
+	}
+	if status, ok := ex.Status.ProviderStatus.Object.(*v1alpha1.CertStatus); ok {
+		return status.DefaultIssuer
+	}
+	status := &v1alpha1.CertStatus{}
+	if err := json.Unmarshal(ex.Status.ProviderStatus.Raw, status); err != nil {
+		return nil
+	}
+	return status.DefaultIssuer
+}
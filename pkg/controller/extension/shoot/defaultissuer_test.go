@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/v1alpha1"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shared"
+)
+
+var _ = Describe("DefaultIssuer", func() {
+	var (
+		now        = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+		candidates = []string{"garden", "zerossl", "corp-ca"}
+
+		newIssuer = func(name, state string) certv1alpha1.Issuer {
+			return certv1alpha1.Issuer{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shoot--foo--bar"},
+				Status:     certv1alpha1.IssuerStatus{State: state},
+			}
+		}
+		newCertificate = func(name, issuerName, state, message string) certv1alpha1.Certificate {
+			return certv1alpha1.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Status: certv1alpha1.CertificateStatus{
+					State:     state,
+					Message:   &message,
+					IssuerRef: &certv1alpha1.QualifiedIssuerRef{Cluster: "default", Name: issuerName, Namespace: "shoot--foo--bar"},
+				},
+			}
+		}
+
+		issuers []certv1alpha1.Issuer
+	)
+
+	BeforeEach(func() {
+		issuers = []certv1alpha1.Issuer{
+			newIssuer("garden", certv1alpha1.StateReady),
+			newIssuer("zerossl", certv1alpha1.StateReady),
+			newIssuer("corp-ca", certv1alpha1.StateReady),
+		}
+	})
+
+	It("should select the issuer provided by Gardener if it is available", func() {
+		status := defaultIssuerStatus(nil, candidates, issuers, nil, time.Hour, now)
+		Expect(status.Name).To(Equal("garden"))
+		Expect(status.Fallback).To(BeFalse())
+		Expect(status.LastTransitionTime.Time).To(Equal(now))
+	})
+
+	It("should select the first fallback issuer if the issuer provided by Gardener is not ready", func() {
+		issuers[0].Status.State = certv1alpha1.StateError
+		status := defaultIssuerStatus(nil, candidates, issuers, nil, time.Hour, now)
+		Expect(status.Name).To(Equal("zerossl"))
+		Expect(status.Fallback).To(BeTrue())
+		Expect(status.Message).To(Equal("Using fallback issuer: garden is not ready (state 'Error')"))
+	})
+
+	It("should skip rate limited issuers", func() {
+		certs := []certv1alpha1.Certificate{
+			newCertificate("a", "garden", certv1alpha1.StateError, "obtaining certificate failed: acme: error: 429 :: POST :: urn:ietf:params:acme:error:rateLimited :: too many certificates"),
+			newCertificate("b", "zerossl", certv1alpha1.StatePending, "request quota exhausted. Retrying in 5 min."),
+			newCertificate("c", "corp-ca", certv1alpha1.StateReady, "certificate (re)issued"),
+		}
+		status := defaultIssuerStatus(nil, candidates, issuers, certs, time.Hour, now)
+		Expect(status.Name).To(Equal("corp-ca"))
+		Expect(status.Message).To(Equal("Using fallback issuer: garden is rate limited (1 certificate(s)), zerossl is rate limited (1 certificate(s))"))
+	})
+
+	It("should keep the issuer provided by Gardener if no issuer is available", func() {
+		issuers = issuers[:1]
+		issuers[0].Status.State = certv1alpha1.StatePending
+		status := defaultIssuerStatus(nil, candidates, issuers, nil, time.Hour, now)
+		Expect(status.Name).To(Equal("garden"))
+		Expect(status.Fallback).To(BeFalse())
+		Expect(status.Message).To(Equal("No default issuer is available: garden is not ready (state 'Pending'), zerossl is not deployed, corp-ca is not deployed"))
+	})
+
+	It("should keep the fallback issuer for the minimum hold duration", func() {
+		previous := &v1alpha1.DefaultIssuerStatus{Name: "zerossl", Fallback: true, LastTransitionTime: metav1.NewTime(now.Add(-30 * time.Minute))}
+		status := defaultIssuerStatus(previous, candidates, issuers, nil, time.Hour, now)
+		Expect(status.Name).To(Equal("zerossl"))
+		Expect(status.LastTransitionTime).To(Equal(previous.LastTransitionTime))
+
+		status = defaultIssuerStatus(previous, candidates, issuers, nil, 20*time.Minute, now)
+		Expect(status.Name).To(Equal("garden"))
+		Expect(status.Fallback).To(BeFalse())
+		Expect(status.LastTransitionTime.Time).To(Equal(now))
+	})
+
+	It("should switch immediately if the held fallback issuer becomes unavailable", func() {
+		issuers[1].Status.State = certv1alpha1.StateError
+		previous := &v1alpha1.DefaultIssuerStatus{Name: "zerossl", Fallback: true, LastTransitionTime: metav1.NewTime(now.Add(-time.Minute))}
+		status := defaultIssuerStatus(previous, candidates, issuers, nil, time.Hour, now)
+		Expect(status.Name).To(Equal("garden"))
+	})
+
+	It("should select by the issuer states only if the shoot cluster is not reachable", func() {
+		scheme := runtime.NewScheme()
+		Expect(certv1alpha1.AddToScheme(scheme)).To(Succeed())
+		issuers[0].Status.State = certv1alpha1.StateError
+		a := &actuator{
+			client: fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(&issuers[0], &issuers[1], &issuers[2]).Build(),
+			newShootAccessClient: func(_ context.Context, _ string) (client.Client, error) {
+				return nil, errors.New("shoot cluster not reachable")
+			},
+		}
+		ex := &extensionsv1alpha1.Extension{ObjectMeta: metav1.ObjectMeta{Name: "shoot-cert-service", Namespace: "shoot--foo--bar"}}
+		values := shared.Values{ExtensionConfig: config.Configuration{
+			IssuerName:            "garden",
+			DefaultIssuerFallback: &config.DefaultIssuerFallback{Issuers: []config.CatalogIssuer{{Name: "zerossl"}, {Name: "corp-ca"}}},
+		}}
+
+		status, err := a.selectDefaultIssuer(context.Background(), logr.Discard(), ex, values, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Name).To(Equal("zerossl"))
+		Expect(status.Fallback).To(BeTrue())
+	})
+
+	It("should read the previous status from the provider status", func() {
+		ex := &extensionsv1alpha1.Extension{}
+		Expect(previousDefaultIssuerStatus(ex)).To(BeNil())
+
+		ex.Status.ProviderStatus = &runtime.RawExtension{
+			Raw: []byte(`{"apiVersion":"service.cert.extensions.gardener.cloud/v1alpha1","kind":"CertStatus","defaultIssuer":{"name":"zerossl","fallback":true,"lastTransitionTime":"2026-10-16T11:00:00Z"}}`),
+		}
+		previous := previousDefaultIssuerStatus(ex)
+		Expect(previous).NotTo(BeNil())
+		Expect(previous.Name).To(Equal("zerossl"))
+		Expect(previous.Fallback).To(BeTrue())
+		Expect(previous.LastTransitionTime.Time).To(BeTemporally("==", now.Add(-time.Hour)))
+	})
+})
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+	configvalidation "github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config/validation"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/validation"
+)
+
+const (
+	// ConditionTypePrivateKeyPolicyCompliant is the type of the Extension condition reporting if the certificates
+	// of the shoot cluster comply with the private key policy of the operator.
+	ConditionTypePrivateKeyPolicyCompliant gardencorev1beta1.ConditionType = "PrivateKeyPolicyCompliant"
+
+	reasonPrivateKeyPolicyCompliant = "PrivateKeyPolicyCompliant"
+	reasonPrivateKeyPolicyViolated  = "PrivateKeyPolicyViolated"
+	reasonCertificatesNotChecked    = "CertificatesNotChecked"
+
+	// maxReportedViolations limits the number of certificates listed in the condition message.
+	maxReportedViolations = 5
+)
+
+// listShootCertificates lists the certificates of the shoot cluster. If the certificate CRD is not deployed yet,
+// no certificates are returned.
+func listShootCertificates(ctx context.Context, shootClient client.Client) ([]certv1alpha1.Certificate, error) {
+	certList := &certv1alpha1.CertificateList{}
+	if err := shootClient.List(ctx, certList); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return certList.Items, nil
+}
+
+// privateKeyPolicyCondition returns the condition reporting if the certificates of the shoot cluster comply with the
+// private key policy.
+func privateKeyPolicyCondition(conditions []gardencorev1beta1.Condition, certs []certv1alpha1.Certificate, listErr error,
+	serviceConfig *config.Configuration, certConfig *service.CertConfig, policies []*config.PrivateKeyPolicy) gardencorev1beta1.Condition {
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock.RealClock{}, conditions, ConditionTypePrivateKeyPolicyCompliant)
+
+	if listErr != nil {
+		return v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, gardencorev1beta1.ConditionUnknown, reasonCertificatesNotChecked,
+			fmt.Sprintf("Failed to list certificates of the shoot cluster: %s", listErr))
+	}
+
+	violations := privateKeyPolicyViolations(certs, serviceConfig, certConfig, policies)
+	if len(violations) == 0 {
+		return v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, gardencorev1beta1.ConditionTrue, reasonPrivateKeyPolicyCompliant,
+			"All certificates comply with the private key policy.")
+	}
+
+	reported := violations
+	if len(reported) > maxReportedViolations {
+		reported = append(reported[:maxReportedViolations:maxReportedViolations], fmt.Sprintf("and %d more", len(violations)-maxReportedViolations))
+	}
+	return v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, gardencorev1beta1.ConditionFalse, reasonPrivateKeyPolicyViolated,
+		fmt.Sprintf("%d certificate(s) violate the private key policy: %s", len(violations), strings.Join(reported, "; ")))
+}
+
+// privateKeyPolicyViolations returns the descriptions of the certificates violating the private key policy.
+// Certificates without explicit private key use the defaults of the shoot cluster, which are validated already.
+func privateKeyPolicyViolations(certs []certv1alpha1.Certificate, serviceConfig *config.Configuration, certConfig *service.CertConfig, policies []*config.PrivateKeyPolicy) []string {
+	defaultAlgorithm, sizeRSA, sizeECDSA := validation.PrivateKeyDefaults(serviceConfig, certConfig)
+
+	var violations []string
+	for _, cert := range certs {
+		if cert.Spec.PrivateKey == nil {
+			continue
+		}
+		algorithm := defaultAlgorithm
+		if cert.Spec.PrivateKey.Algorithm != nil {
+			algorithm = string(*cert.Spec.PrivateKey.Algorithm)
+		}
+		size := sizeRSA
+		if algorithm == string(certv1alpha1.ECDSAKeyAlgorithm) {
+			size = sizeECDSA
+		}
+		if cert.Spec.PrivateKey.Size != nil {
+			size = int(*cert.Spec.PrivateKey.Size)
+		}
+		if msg := configvalidation.PrivateKeyPolicyViolation(algorithm, size, policies...); msg != "" {
+			violations = append(violations, fmt.Sprintf("%s/%s (%s/%d): %s", cert.Namespace, cert.Name, algorithm, size, msg))
+		}
+	}
+	return violations
+}
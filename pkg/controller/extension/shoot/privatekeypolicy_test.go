@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"errors"
+	"fmt"
+
+	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
+)
+
+var _ = Describe("PrivateKeyPolicy", func() {
+	var (
+		serviceConfig *config.Configuration
+		certConfig    *service.CertConfig
+		policies      []*config.PrivateKeyPolicy
+
+		newCertificate = func(name string, algorithm *certv1alpha1.PrivateKeyAlgorithm, size *certv1alpha1.PrivateKeySize) certv1alpha1.Certificate {
+			cert := certv1alpha1.Certificate{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+			if algorithm != nil || size != nil {
+				cert.Spec.PrivateKey = &certv1alpha1.CertificatePrivateKey{Algorithm: algorithm, Size: size}
+			}
+			return cert
+		}
+		rsa   = new(certv1alpha1.RSAKeyAlgorithm)
+		ecdsa = new(certv1alpha1.ECDSAKeyAlgorithm)
+	)
+
+	BeforeEach(func() {
+		serviceConfig = &config.Configuration{
+			PrivateKeyPolicy: &config.PrivateKeyPolicy{
+				AllowedAlgorithms: []string{"RSA"},
+				MinSizeRSA:        new(3072),
+			},
+		}
+		certConfig = &service.CertConfig{}
+		policies = []*config.PrivateKeyPolicy{serviceConfig.PrivateKeyPolicy}
+	})
+
+	It("should report the certificates violating the policy", func() {
+		certs := []certv1alpha1.Certificate{
+			newCertificate("default-key", nil, nil),
+			newCertificate("rsa-default-size", rsa, nil),
+			newCertificate("rsa-2048", rsa, new(certv1alpha1.PrivateKeySize(2048))),
+			newCertificate("ecdsa", ecdsa, nil),
+		}
+		Expect(privateKeyPolicyViolations(certs, serviceConfig, certConfig, policies)).To(ConsistOf(
+			"default/rsa-2048 (RSA/2048): size for RSA algorithm must be at least '3072' by the private key policy",
+			"default/ecdsa (ECDSA/384): algorithm is not allowed by the private key policy (allowed: RSA)",
+		))
+	})
+
+	It("should use the private key defaults of the shoot", func() {
+		certConfig.PrivateKeyDefaults = &service.PrivateKeyDefaults{SizeRSA: new(int32(2048))}
+		certs := []certv1alpha1.Certificate{
+			newCertificate("rsa-default-size", rsa, nil),
+		}
+		Expect(privateKeyPolicyViolations(certs, serviceConfig, certConfig, policies)).To(ConsistOf(
+			"default/rsa-default-size (RSA/2048): size for RSA algorithm must be at least '3072' by the private key policy",
+		))
+	})
+
+	It("should return a compliant condition", func() {
+		condition := privateKeyPolicyCondition(nil, []certv1alpha1.Certificate{newCertificate("foo", rsa, nil)}, nil, serviceConfig, certConfig, policies)
+		Expect(condition.Type).To(Equal(ConditionTypePrivateKeyPolicyCompliant))
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(reasonPrivateKeyPolicyCompliant))
+	})
+
+	It("should return a condition listing a limited number of violations", func() {
+		var certs []certv1alpha1.Certificate
+		for i := range maxReportedViolations + 2 {
+			certs = append(certs, newCertificate(fmt.Sprintf("cert-%d", i), ecdsa, nil))
+		}
+		condition := privateKeyPolicyCondition(nil, certs, nil, serviceConfig, certConfig, policies)
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(reasonPrivateKeyPolicyViolated))
+		Expect(condition.Message).To(HavePrefix("7 certificate(s) violate the private key policy: default/cert-0 "))
+		Expect(condition.Message).To(HaveSuffix("; and 2 more"))
+	})
+
+	It("should return an unknown condition if the certificates cannot be listed", func() {
+		condition := privateKeyPolicyCondition(nil, nil, errors.New("boom"), serviceConfig, certConfig, policies)
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionUnknown))
+		Expect(condition.Reason).To(Equal(reasonCertificatesNotChecked))
+		Expect(condition.Message).To(ContainSubstring("boom"))
+	})
+})
@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/v1alpha1"
+)
+
+// certificateSecretLabel is the label set by the cert-controller-manager on the secrets of issued certificates.
+const certificateSecretLabel = certv1alpha1.GroupName + "/certificate"
+
+type replicationSource struct {
+	key      client.ObjectKey
+	selector labels.Selector
+}
+
+// ReplicateSecrets replicates the certificate secrets configured in the provider config or selected by the
+// annotation on certificates into the matching namespaces of the shoot cluster. Only secrets issued by the
+// cert-controller-manager are replicated. Secrets existing in a target namespace are only overwritten if they are replicas.
+// Replicas which are not desired anymore are deleted.
+func ReplicateSecrets(ctx context.Context, log logr.Logger, shootClient client.Client, replication *service.SecretReplication) error {
+	if replication == nil || !replication.Enabled {
+		return nil
+	}
+
+	sources, err := collectReplicationSources(ctx, log, shootClient, replication)
+	if err != nil {
+		return err
+	}
+
+	namespaceList := &corev1.NamespaceList{}
+	if err := shootClient.List(ctx, namespaceList); err != nil {
+		return fmt.Errorf("failed to list shoot namespaces: %w", err)
+	}
+
+	desired := map[client.ObjectKey]struct{}{}
+	for _, source := range sources {
+		secret := &corev1.Secret{}
+		if err := shootClient.Get(ctx, source.key, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Info("Secret to replicate not found", "secret", source.key)
+				continue
+			}
+			return fmt.Errorf("failed to get secret %s to replicate: %w", source.key, err)
+		}
+		if secret.Labels[certificateSecretLabel] != "true" {
+			log.Info("Secret to replicate is not issued for a certificate, skipping replication", "secret", source.key)
+			continue
+		}
+
+		for _, ns := range namespaceList.Items {
+			if ns.Name == source.key.Namespace || ns.DeletionTimestamp != nil || ns.Status.Phase == corev1.NamespaceTerminating ||
+				!source.selector.Matches(labels.Set(ns.Labels)) {
+				continue
+			}
+			replicaKey := client.ObjectKey{Namespace: ns.Name, Name: source.key.Name}
+			if _, ok := desired[replicaKey]; ok {
+				log.Info("Secret is already replicated from another source", "secret", replicaKey, "source", source.key)
+				continue
+			}
+			desired[replicaKey] = struct{}{}
+			if err := replicateSecret(ctx, log, shootClient, secret, replicaKey); err != nil {
+				return err
+			}
+		}
+	}
+
+	replicaList := &corev1.SecretList{}
+	if err := shootClient.List(ctx, replicaList, client.HasLabels{v1alpha1.ReplicaLabel}); err != nil {
+		return fmt.Errorf("failed to list replicated secrets: %w", err)
+	}
+	for i := range replicaList.Items {
+		replica := &replicaList.Items[i]
+		if _, ok := desired[client.ObjectKeyFromObject(replica)]; ok {
+			continue
+		}
+		if err := shootClient.Delete(ctx, replica); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete replicated secret %s: %w", client.ObjectKeyFromObject(replica), err)
+		}
+	}
+	return nil
+}
+
+// DeleteSecretReplicas deletes all secrets replicated by the shoot cert service in the shoot cluster.
+func DeleteSecretReplicas(ctx context.Context, shootClient client.Client) error {
+	replicaList := &corev1.SecretList{}
+	if err := shootClient.List(ctx, replicaList, client.HasLabels{v1alpha1.ReplicaLabel}); err != nil {
+		return fmt.Errorf("failed to list replicated secrets: %w", err)
+	}
+	for i := range replicaList.Items {
+		if err := shootClient.Delete(ctx, &replicaList.Items[i]); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete replicated secret %s: %w", client.ObjectKeyFromObject(&replicaList.Items[i]), err)
+		}
+	}
+	return nil
+}
+
+func collectReplicationSources(ctx context.Context, log logr.Logger, shootClient client.Client, replication *service.SecretReplication) ([]replicationSource, error) {
+	var sources []replicationSource
+	for _, secret := range replication.Secrets {
+		selector, err := metav1.LabelSelectorAsSelector(&secret.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespace selector for secret %s: %w", secret.SecretName, err)
+		}
+		sources = append(sources, replicationSource{
+			key:      client.ObjectKey{Namespace: ptr.Deref(secret.SecretNamespace, metav1.NamespaceDefault), Name: secret.SecretName},
+			selector: selector,
+		})
+	}
+
+	certList := &certv1alpha1.CertificateList{}
+	if err := shootClient.List(ctx, certList); err != nil {
+		if meta.IsNoMatchError(err) {
+			// certificate CRD not deployed yet
+			return sources, nil
+		}
+		return nil, fmt.Errorf("failed to list certificates: %w", err)
+	}
+	for _, cert := range certList.Items {
+		value, ok := cert.Annotations[v1alpha1.ReplicateToNamespacesAnnotation]
+		if !ok {
+			continue
+		}
+		selector, err := labels.Parse(value)
+		if err != nil {
+			log.Info("Ignoring invalid namespace selector of certificate", "certificate", client.ObjectKeyFromObject(&cert), "error", err.Error())
+			continue
+		}
+		key := client.ObjectKey{Namespace: cert.Namespace, Name: ptr.Deref(cert.Spec.SecretName, "")}
+		if ref := cert.Spec.SecretRef; ref != nil {
+			if ref.Namespace != "" && ref.Namespace != cert.Namespace {
+				log.Info("Ignoring certificate with secret in other namespace for replication", "certificate", client.ObjectKeyFromObject(&cert))
+				continue
+			}
+			key.Name = ref.Name
+		}
+		if key.Name == "" {
+			continue
+		}
+		sources = append(sources, replicationSource{key: key, selector: selector})
+	}
+	return sources, nil
+}
+
+func replicateSecret(ctx context.Context, log logr.Logger, shootClient client.Client, secret *corev1.Secret, replicaKey client.ObjectKey) error {
+	replica := &corev1.Secret{}
+	if err := shootClient.Get(ctx, replicaKey, replica); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get replicated secret %s: %w", replicaKey, err)
+		}
+		replica = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        replicaKey.Name,
+				Namespace:   replicaKey.Namespace,
+				Labels:      map[string]string{v1alpha1.ReplicaLabel: "true"},
+				Annotations: map[string]string{v1alpha1.ReplicatedFromAnnotation: client.ObjectKeyFromObject(secret).String()},
+			},
+			Type: secret.Type,
+			Data: secret.Data,
+		}
+		if err := shootClient.Create(ctx, replica); err != nil {
+			return fmt.Errorf("failed to create replicated secret %s: %w", replicaKey, err)
+		}
+		return nil
+	}
+
+	if _, ok := replica.Labels[v1alpha1.ReplicaLabel]; !ok {
+		log.Info("Secret exists and is not a replica, skipping replication", "secret", replicaKey)
+		return nil
+	}
+	if maps.EqualFunc(replica.Data, secret.Data, func(a, b []byte) bool { return string(a) == string(b) }) &&
+		replica.Annotations[v1alpha1.ReplicatedFromAnnotation] == client.ObjectKeyFromObject(secret).String() {
+		return nil
+	}
+	metav1.SetMetaDataAnnotation(&replica.ObjectMeta, v1alpha1.ReplicatedFromAnnotation, client.ObjectKeyFromObject(secret).String())
+	replica.Data = secret.Data
+	if err := shootClient.Update(ctx, replica); err != nil {
+		return fmt.Errorf("failed to update replicated secret %s: %w", replicaKey, err)
+	}
+	return nil
+}
+
+// deleteSecretReplicas deletes the replicated secrets in the shoot cluster if the replication was enabled and the
+// shoot cluster is not deleted anyway.
+func (a *actuator) deleteSecretReplicas(ctx context.Context, ex *extensionsv1alpha1.Extension, cluster *controller.Cluster) error {
+	if !previousSecretReplicationEnabled(ex) || controller.IsHibernated(cluster) || cluster.Shoot.DeletionTimestamp != nil {
+		return nil
+	}
+
+	shootClient, err := a.newShootAccessClient(ctx, ex.GetNamespace())
+	if err != nil {
+		return fmt.Errorf("failed to create shoot client: %w", err)
+	}
+	return DeleteSecretReplicas(ctx, shootClient)
+}
+
+// previousSecretReplicationEnabled returns if the secret replication was enabled according to the provider status
+// of the Extension resource.
+func previousSecretReplicationEnabled(ex *extensionsv1alpha1.Extension) bool {
+	if ex.Status.ProviderStatus == nil {
+		return false
+	}
+	if status, ok := ex.Status.ProviderStatus.Object.(*v1alpha1.CertStatus); ok {
+		return status.SecretReplicationEnabled
+	}
+	status := &v1alpha1.CertStatus{}
+	if err := json.Unmarshal(ex.Status.ProviderStatus.Raw, status); err != nil {
+		return false
+	}
+	return status.SecretReplicationEnabled
+}
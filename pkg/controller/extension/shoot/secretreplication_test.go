@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/v1alpha1"
+)
+
+var _ = Describe("SecretReplication", func() {
+	var (
+		ctx = context.Background()
+
+		a           *actuator
+		shootClient client.Client
+		ex          *extensionsv1alpha1.Extension
+		cluster     *controller.Cluster
+	)
+
+	BeforeEach(func() {
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithObjects(
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "wildcard-tls", Namespace: "default"}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "wildcard-tls", Namespace: "app1", Labels: map[string]string{v1alpha1.ReplicaLabel: "true"}}},
+		).Build()
+		a = &actuator{
+			newShootAccessClient: func(_ context.Context, namespace string) (client.Client, error) {
+				Expect(namespace).To(Equal("shoot--foo--bar"))
+				return shootClient, nil
+			},
+		}
+		ex = &extensionsv1alpha1.Extension{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot-cert-service", Namespace: "shoot--foo--bar"},
+			Status: extensionsv1alpha1.ExtensionStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					ProviderStatus: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"service.cert.extensions.gardener.cloud/v1alpha1","kind":"CertStatus","secretReplicationEnabled":true}`)},
+				},
+			},
+		}
+		cluster = &controller.Cluster{Shoot: &gardencorev1beta1.Shoot{}}
+	})
+
+	It("should delete the replicas of a previously enabled replication", func() {
+		Expect(a.deleteSecretReplicas(ctx, ex, cluster)).To(Succeed())
+
+		Expect(shootClient.Get(ctx, client.ObjectKey{Namespace: "app1", Name: "wildcard-tls"}, &corev1.Secret{})).To(BeNotFoundError())
+		Expect(shootClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "wildcard-tls"}, &corev1.Secret{})).To(Succeed())
+	})
+
+	It("should not access the shoot cluster if the replication was not enabled", func() {
+		ex.Status.ProviderStatus = nil
+		a.newShootAccessClient = func(_ context.Context, _ string) (client.Client, error) {
+			Fail("shoot client must not be created")
+			return nil, nil
+		}
+
+		Expect(a.deleteSecretReplicas(ctx, ex, cluster)).To(Succeed())
+	})
+})
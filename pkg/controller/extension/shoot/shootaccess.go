@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	kubernetesclient "github.com/gardener/gardener/pkg/client/kubernetes"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/v1alpha1"
+)
+
+// NewShootAccessClient creates a client for the shoot cluster of the given shoot namespace in the seed, which
+// authenticates with the token of the shoot access secret of the extension instead of the admin credentials.
+// Its permissions are restricted to the cluster role deployed with the shoot managed resource.
+func NewShootAccessClient(ctx context.Context, c client.Reader, namespace string, opts client.Options) (client.Client, error) {
+	accessConfig, err := NewShootAccessRESTConfig(ctx, c, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return client.New(accessConfig, opts)
+}
+
+// NewShootAccessRESTConfig creates the REST config for the shoot cluster of the given shoot namespace in the seed, which
+// authenticates with the token of the shoot access secret of the extension. As the token is renewed regularly, it is
+// read from the secret for each request, so that the config can also be used for long-running watches.
+func NewShootAccessRESTConfig(ctx context.Context, c client.Reader, namespace string) (*rest.Config, error) {
+	gardenerSecret := &corev1.Secret{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: v1beta1constants.SecretNameGardenerInternal}, gardenerSecret)
+	if apierrors.IsNotFound(err) {
+		err = c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: v1beta1constants.SecretNameGardener}, gardenerSecret)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kubeconfig of shoot cluster: %w", err)
+	}
+	restConfig, err := kubernetesclient.RESTConfigFromKubeconfig(
+		gardenerSecret.Data[secrets.DataKeyKubeconfig],
+		kubernetesclient.AuthTokenFile,
+		kubernetesclient.AuthClientKey,
+		kubernetesclient.AuthClientCertificate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenSource := &shootAccessToken{
+		reader: c,
+		key:    client.ObjectKey{Namespace: namespace, Name: gardenerutils.SecretNamePrefixShootAccess + v1alpha1.ShootAccessSecretName},
+	}
+	if _, err := tokenSource.token(ctx); err != nil {
+		return nil, err
+	}
+
+	// only the endpoint and the CA of the admin kubeconfig are kept
+	accessConfig := rest.AnonymousClientConfig(restConfig)
+	accessConfig.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		return &shootAccessTokenRoundTripper{tokenSource: tokenSource, delegate: rt}
+	}
+	return accessConfig, nil
+}
+
+// shootAccessToken reads the token of the shoot access secret.
+type shootAccessToken struct {
+	reader client.Reader
+	key    client.ObjectKey
+}
+
+func (t *shootAccessToken) token(ctx context.Context) (string, error) {
+	accessSecret := &corev1.Secret{}
+	if err := t.reader.Get(ctx, t.key, accessSecret); err != nil {
+		return "", fmt.Errorf("failed to get shoot access secret: %w", err)
+	}
+	token := accessSecret.Data[resourcesv1alpha1.DataKeyToken]
+	if len(token) == 0 {
+		return "", fmt.Errorf("shoot access secret %s has no token yet", t.key)
+	}
+	return string(token), nil
+}
+
+// shootAccessTokenRoundTripper sets the current token of the shoot access secret as bearer token of the requests.
+type shootAccessTokenRoundTripper struct {
+	tokenSource *shootAccessToken
+	delegate    http.RoundTripper
+}
+
+func (rt *shootAccessTokenRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := rt.tokenSource.token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	req = utilnet.CloneRequest(req)
+	req.Header.Set("Authorization", "Bearer "+token)
+	return rt.delegate.RoundTrip(req)
+}
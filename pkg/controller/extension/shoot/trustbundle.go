@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/v1alpha1"
+)
+
+// CopyTrustBundle copies the trust bundle config map of the `kube-system` namespace into the namespaces of the shoot
+// cluster matching the namespace selector of the trust bundle. Config maps existing in a target namespace are only
+// overwritten if they are copies. Copies which are not desired anymore are deleted.
+func CopyTrustBundle(ctx context.Context, log logr.Logger, shootClient client.Client, trustBundle *service.TrustBundle) error {
+	if trustBundle == nil || !trustBundle.Enabled || trustBundle.NamespaceSelector == nil {
+		return nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(trustBundle.NamespaceSelector)
+	if err != nil {
+		return fmt.Errorf("invalid namespace selector for trust bundle: %w", err)
+	}
+
+	desired := sets.New[string]()
+	source := &corev1.ConfigMap{}
+	if err := shootClient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceSystem, Name: v1alpha1.TrustBundleConfigMapName}, source); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get trust bundle: %w", err)
+		}
+		// the config map is not deployed without CA certificates, so the copies are deleted as well
+		log.Info("Trust bundle not found, deleting copies")
+	} else {
+		namespaceList := &corev1.NamespaceList{}
+		if err := shootClient.List(ctx, namespaceList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return fmt.Errorf("failed to list shoot namespaces: %w", err)
+		}
+		for _, ns := range namespaceList.Items {
+			if ns.Name == metav1.NamespaceSystem || ns.DeletionTimestamp != nil || ns.Status.Phase == corev1.NamespaceTerminating {
+				continue
+			}
+			desired.Insert(ns.Name)
+			if err := copyTrustBundle(ctx, log, shootClient, source, ns.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	copyList := &corev1.ConfigMapList{}
+	if err := shootClient.List(ctx, copyList, client.HasLabels{v1alpha1.TrustBundleCopyLabel}); err != nil {
+		return fmt.Errorf("failed to list copies of trust bundle: %w", err)
+	}
+	for i := range copyList.Items {
+		if desired.Has(copyList.Items[i].Namespace) {
+			continue
+		}
+		if err := shootClient.Delete(ctx, &copyList.Items[i]); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete copy of trust bundle %s: %w", client.ObjectKeyFromObject(&copyList.Items[i]), err)
+		}
+	}
+	return nil
+}
+
+// DeleteTrustBundleCopies deletes all copies of the trust bundle config map in the shoot cluster.
+func DeleteTrustBundleCopies(ctx context.Context, shootClient client.Client) error {
+	copyList := &corev1.ConfigMapList{}
+	if err := shootClient.List(ctx, copyList, client.HasLabels{v1alpha1.TrustBundleCopyLabel}); err != nil {
+		return fmt.Errorf("failed to list copies of trust bundle: %w", err)
+	}
+	for i := range copyList.Items {
+		if err := shootClient.Delete(ctx, &copyList.Items[i]); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete copy of trust bundle %s: %w", client.ObjectKeyFromObject(&copyList.Items[i]), err)
+		}
+	}
+	return nil
+}
+
+func copyTrustBundle(ctx context.Context, log logr.Logger, shootClient client.Client, source *corev1.ConfigMap, namespace string) error {
+	key := client.ObjectKey{Namespace: namespace, Name: source.Name}
+	configMap := &corev1.ConfigMap{}
+	if err := shootClient.Get(ctx, key, configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get copy of trust bundle %s: %w", key, err)
+		}
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    map[string]string{v1alpha1.TrustBundleCopyLabel: "true"},
+			},
+			Data: source.Data,
+		}
+		if err := shootClient.Create(ctx, configMap); err != nil {
+			return fmt.Errorf("failed to create copy of trust bundle %s: %w", key, err)
+		}
+		return nil
+	}
+
+	if _, ok := configMap.Labels[v1alpha1.TrustBundleCopyLabel]; !ok {
+		log.Info("Config map exists and is not a copy of the trust bundle, skipping copy", "configMap", key)
+		return nil
+	}
+	if maps.Equal(configMap.Data, source.Data) {
+		return nil
+	}
+	configMap.Data = source.Data
+	if err := shootClient.Update(ctx, configMap); err != nil {
+		return fmt.Errorf("failed to update copy of trust bundle %s: %w", key, err)
+	}
+	return nil
+}
+
+// deleteTrustBundleCopies deletes the copies of the trust bundle in the shoot cluster if they were enabled and the
+// shoot cluster is not deleted anyway.
+func (a *actuator) deleteTrustBundleCopies(ctx context.Context, ex *extensionsv1alpha1.Extension, cluster *controller.Cluster) error {
+	if !previousTrustBundleCopiesEnabled(ex) || controller.IsHibernated(cluster) || cluster.Shoot.DeletionTimestamp != nil {
+		return nil
+	}
+
+	shootClient, err := a.newShootAccessClient(ctx, ex.GetNamespace())
+	if err != nil {
+		return fmt.Errorf("failed to create shoot client: %w", err)
+	}
+	return DeleteTrustBundleCopies(ctx, shootClient)
+}
+
+// previousTrustBundleCopiesEnabled returns if the copies of the trust bundle were enabled according to the provider
+// status of the Extension resource.
+func previousTrustBundleCopiesEnabled(ex *extensionsv1alpha1.Extension) bool {
+	if ex.Status.ProviderStatus == nil {
+		return false
+	}
+	if status, ok := ex.Status.ProviderStatus.Object.(*v1alpha1.CertStatus); ok {
+		return status.TrustBundleCopiesEnabled
+	}
+	status := &v1alpha1.CertStatus{}
+	if err := json.Unmarshal(ex.Status.ProviderStatus.Raw, status); err != nil {
+		return false
+	}
+	return status.TrustBundleCopiesEnabled
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/v1alpha1"
+)
+
+var _ = Describe("TrustBundle", func() {
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+
+		shootClient client.Client
+		trustBundle *service.TrustBundle
+
+		trustBundleConfigMap = func(namespace, bundle string, labels map[string]string) *corev1.ConfigMap {
+			return &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.TrustBundleConfigMapName, Namespace: namespace, Labels: labels},
+				Data:       map[string]string{"bundle.crt": bundle},
+			}
+		}
+		getConfigMap = func(namespace string) *corev1.ConfigMap {
+			configMap := &corev1.ConfigMap{}
+			Expect(shootClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: v1alpha1.TrustBundleConfigMapName}, configMap)).To(Succeed())
+			return configMap
+		}
+		expectNoConfigMap = func(namespace string) {
+			Expect(shootClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: v1alpha1.TrustBundleConfigMapName}, &corev1.ConfigMap{})).To(BeNotFoundError())
+		}
+	)
+
+	BeforeEach(func() {
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system", Labels: map[string]string{"trust": "true"}}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "app1", Labels: map[string]string{"trust": "true"}}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "app2", Labels: map[string]string{"trust": "true"}}, Status: corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+			trustBundleConfigMap("kube-system", "ca1", nil),
+		).Build()
+		trustBundle = &service.TrustBundle{
+			Enabled:           true,
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"trust": "true"}},
+		}
+	})
+
+	Describe("#CopyTrustBundle", func() {
+		It("should copy the trust bundle into the active selected namespaces and update the copies", func() {
+			Expect(CopyTrustBundle(ctx, log, shootClient, trustBundle)).To(Succeed())
+
+			configMap := getConfigMap("app1")
+			Expect(configMap.Labels).To(HaveKeyWithValue(v1alpha1.TrustBundleCopyLabel, "true"))
+			Expect(configMap.Data).To(HaveKeyWithValue("bundle.crt", "ca1"))
+			expectNoConfigMap("app2")
+			expectNoConfigMap("other")
+			Expect(getConfigMap("kube-system").Labels).To(BeEmpty())
+
+			source := getConfigMap("kube-system")
+			source.Data["bundle.crt"] = "ca2"
+			Expect(shootClient.Update(ctx, source)).To(Succeed())
+			Expect(CopyTrustBundle(ctx, log, shootClient, trustBundle)).To(Succeed())
+
+			Expect(getConfigMap("app1").Data).To(HaveKeyWithValue("bundle.crt", "ca2"))
+		})
+
+		It("should not overwrite an existing config map which is no copy", func() {
+			Expect(shootClient.Create(ctx, trustBundleConfigMap("app1", "own", nil))).To(Succeed())
+
+			Expect(CopyTrustBundle(ctx, log, shootClient, trustBundle)).To(Succeed())
+
+			Expect(getConfigMap("app1").Data).To(HaveKeyWithValue("bundle.crt", "own"))
+		})
+
+		It("should delete copies of namespaces which are not selected anymore", func() {
+			Expect(CopyTrustBundle(ctx, log, shootClient, trustBundle)).To(Succeed())
+			getConfigMap("app1")
+
+			trustBundle.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"trust": "other"}}
+			Expect(CopyTrustBundle(ctx, log, shootClient, trustBundle)).To(Succeed())
+
+			expectNoConfigMap("app1")
+			getConfigMap("kube-system")
+		})
+
+		It("should delete the copies if the trust bundle does not exist", func() {
+			Expect(CopyTrustBundle(ctx, log, shootClient, trustBundle)).To(Succeed())
+			Expect(shootClient.Delete(ctx, getConfigMap("kube-system"))).To(Succeed())
+
+			Expect(CopyTrustBundle(ctx, log, shootClient, trustBundle)).To(Succeed())
+
+			expectNoConfigMap("app1")
+		})
+	})
+
+	Describe("#deleteTrustBundleCopies", func() {
+		var (
+			a       *actuator
+			ex      *extensionsv1alpha1.Extension
+			cluster *controller.Cluster
+		)
+
+		BeforeEach(func() {
+			Expect(CopyTrustBundle(ctx, log, shootClient, trustBundle)).To(Succeed())
+			a = &actuator{
+				newShootAccessClient: func(_ context.Context, namespace string) (client.Client, error) {
+					Expect(namespace).To(Equal("shoot--foo--bar"))
+					return shootClient, nil
+				},
+			}
+			ex = &extensionsv1alpha1.Extension{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot-cert-service", Namespace: "shoot--foo--bar"},
+				Status: extensionsv1alpha1.ExtensionStatus{
+					DefaultStatus: extensionsv1alpha1.DefaultStatus{
+						ProviderStatus: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"service.cert.extensions.gardener.cloud/v1alpha1","kind":"CertStatus","trustBundleCopiesEnabled":true}`)},
+					},
+				},
+			}
+			cluster = &controller.Cluster{Shoot: &gardencorev1beta1.Shoot{}}
+		})
+
+		It("should delete the copies of previously enabled copies", func() {
+			Expect(a.deleteTrustBundleCopies(ctx, ex, cluster)).To(Succeed())
+
+			expectNoConfigMap("app1")
+			getConfigMap("kube-system")
+		})
+
+		It("should not access the shoot cluster if the copies were not enabled", func() {
+			ex.Status.ProviderStatus = nil
+			a.newShootAccessClient = func(_ context.Context, _ string) (client.Client, error) {
+				Fail("shoot client must not be created")
+				return nil, nil
+			}
+
+			Expect(a.deleteTrustBundleCopies(ctx, ex, cluster)).To(Succeed())
+		})
+	})
+})
@@ -9,7 +9,6 @@ import (
 	"time"
 
 	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
-	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
 	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck/general"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -34,14 +33,6 @@ var (
 // RegisterHealthChecks registers health checks for each extension resource
 // HealthChecks are grouped by extension (e.g worker), extension.type (e.g aws) and  Health Check Type (e.g SystemComponentsHealthy)
 func RegisterHealthChecks(_ context.Context, mgr manager.Manager, opts healthcheck.DefaultAddArgs) error {
-	preCheckFunc := func(_ context.Context, _ client.Client, _ client.Object, clusterObj any) bool {
-		cluster, ok := clusterObj.(*extensionscontroller.Cluster)
-		if !ok {
-			return false
-		}
-		return cluster.Shoot.Spec.DNS != nil && cluster.Shoot.Spec.DNS.Domain != nil
-	}
-
 	return healthcheck.DefaultRegistration(
 		shoot.Type,
 		extensionsv1alpha1.SchemeGroupVersion.WithKind(extensionsv1alpha1.ExtensionResource),
@@ -55,7 +46,6 @@ func RegisterHealthChecks(_ context.Context, mgr manager.Manager, opts healthche
 				ConditionType: string(gardencorev1beta1.ShootControlPlaneHealthy),
 				HealthCheck: NewIssuerWrapperHealthChecker(
 					general.CheckManagedResource(certv1alpha1.CertManagementResourceNameSeed)),
-				PreCheckFunc: preCheckFunc,
 			},
 		},
 		sets.Set[gardencorev1beta1.ConditionType]{},
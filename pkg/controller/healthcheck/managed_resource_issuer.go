@@ -7,6 +7,7 @@ package healthcheck
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
 	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
@@ -15,6 +16,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shared"
 )
 
 func NewIssuerWrapperHealthChecker(inner healthcheck.HealthCheck) *IssuerWrapperHealthChecker {
@@ -58,21 +61,47 @@ func (healthChecker *IssuerWrapperHealthChecker) Check(ctx context.Context, requ
 		healthChecker.logger.Error(err, "Health check failed")
 		return nil, err
 	}
-	notReady := map[string]string{}
+	// issuers of the issuer catalog are provided by the operator and therefore reported separately
+	var (
+		count, catalogCount       int
+		notReady, catalogNotReady = map[string]string{}, map[string]string{}
+	)
 	for _, issuer := range list.Items {
+		if _, fallback := issuer.Labels[shared.LabelFallbackIssuer]; fallback {
+			// fallback issuers are only used while the default issuer is not available, the selected default issuer
+			// is reported in the provider status of the Extension resource
+			continue
+		}
+		_, catalog := issuer.Labels[shared.LabelCatalogIssuer]
+		if catalog {
+			catalogCount++
+		} else {
+			count++
+		}
 		if issuer.Status.State != certv1alpha1.StateReady {
 			msg := fmt.Sprintf("state='%s'", issuer.Status.State)
 			if issuer.Status.Message != nil {
 				msg += ", message=" + *issuer.Status.Message
 			}
-			notReady[issuer.Name] = msg
+			if catalog {
+				catalogNotReady[issuer.Name] = msg
+			} else {
+				notReady[issuer.Name] = msg
+			}
 		}
 	}
-	if len(notReady) > 0 {
-		healthChecker.logger.Info("Health check failed: issuers not ready", "issuers", notReady)
+	if len(notReady)+len(catalogNotReady) > 0 {
+		var details []string
+		if len(notReady) > 0 {
+			details = append(details, fmt.Sprintf("%d/%d issuers not ready: %v", len(notReady), count, notReady))
+		}
+		if len(catalogNotReady) > 0 {
+			details = append(details, fmt.Sprintf("%d/%d catalog issuers not ready: %v", len(catalogNotReady), catalogCount, catalogNotReady))
+		}
+		healthChecker.logger.Info("Health check failed: issuers not ready", "issuers", notReady, "catalogIssuers", catalogNotReady)
 		return &healthcheck.SingleCheckResult{
 			Status: gardencorev1beta1.ConditionFalse,
-			Detail: fmt.Sprintf("%d/%d issuers not ready: %v", len(notReady), len(list.Items), notReady),
+			Detail: strings.Join(details, "; "),
 		}, nil
 	}
 
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreplication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shared"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shoot"
+)
+
+// Reconciler replicates certificate secrets into the selected namespaces of the shoot cluster and keeps the replicas
+// in sync with the renewed certificates.
+type Reconciler struct {
+	// Client is the client for the seed cluster.
+	Client            client.Client
+	CertConfigDecoder shared.CertConfigDecoder
+	SyncPeriod        time.Duration
+	// NewShootClient creates a client for the shoot cluster of the given shoot namespace in the seed,
+	// which has the permissions of the shoot access secret of the extension.
+	NewShootClient func(ctx context.Context, namespace string) (client.Client, error)
+}
+
+// Reconcile replicates the certificate secrets of the shoot cluster belonging to the extension.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ex := &extensionsv1alpha1.Extension{}
+	if err := r.Client.Get(ctx, request.NamespacedName, ex); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving extension: %w", err)
+	}
+	if ex.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, r.Client, ex.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if extensionscontroller.IsHibernated(cluster) || cluster.Shoot.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	certConfig, err := r.CertConfigDecoder.DecodeAndValidateProviderConfig(ex, cluster)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if certConfig.SecretReplication == nil || !certConfig.SecretReplication.Enabled {
+		// replicas of a previously enabled replication are deleted by the extension actuator
+		return reconcile.Result{}, nil
+	}
+
+	shootClient, err := r.NewShootClient(ctx, ex.Namespace)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to create shoot client: %w", err)
+	}
+	if err := shoot.ReplicateSecrets(ctx, log, shootClient, certConfig.SecretReplication); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{RequeueAfter: r.SyncPeriod}, nil
+}
@@ -0,0 +1,217 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secretreplication
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	certv1alpha1 "github.com/gardener/cert-management/pkg/apis/cert/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/install"
+	certserviceclient "github.com/gardener/gardener-extension-shoot-cert-service/pkg/client"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shared"
+)
+
+var _ = Describe("Reconciler", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx         = context.Background()
+		seedClient  client.Client
+		shootClient client.Client
+		r           *Reconciler
+		ex          *extensionsv1alpha1.Extension
+
+		providerConfig = `{"apiVersion":"service.cert.extensions.gardener.cloud/v1alpha1","kind":"CertConfig",
+"secretReplication":{"enabled":true,"secrets":[{"secretName":"wildcard-tls","namespaceSelector":{"matchLabels":{"team":"a"}}}]}}`
+
+		reconcileExtension = func(expectedRequeueAfter time.Duration) {
+			result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(ex)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(expectedRequeueAfter))
+		}
+		namespaceWithLabels = func(name string, labels map[string]string) *corev1.Namespace {
+			return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+		}
+		tlsSecret = func(namespace, name, cert string) *corev1.Secret {
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Labels:    map[string]string{"cert.gardener.cloud/certificate": "true"},
+				},
+				Type: corev1.SecretTypeTLS,
+				Data: map[string][]byte{corev1.TLSCertKey: []byte(cert), corev1.TLSPrivateKeyKey: []byte("key")},
+			}
+		}
+		getSecret = func(namespace, name string) *corev1.Secret {
+			secret := &corev1.Secret{}
+			Expect(shootClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret)).To(Succeed())
+			return secret
+		}
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(kubernetesscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(install.AddToScheme(scheme)).To(Succeed())
+
+		shootRaw, err := json.Marshal(&gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{DNS: &gardencorev1beta1.DNS{Domain: new("foo.example.com")}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		ex = &extensionsv1alpha1.Extension{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot-cert-service", Namespace: namespace},
+			Spec: extensionsv1alpha1.ExtensionSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:           "shoot-cert-service",
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(providerConfig)},
+				},
+			},
+		}
+		seedClient = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(
+			ex,
+			&extensionsv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: namespace},
+				Spec: extensionsv1alpha1.ClusterSpec{
+					CloudProfile: runtime.RawExtension{Raw: []byte("{}")},
+					Seed:         &runtime.RawExtension{Raw: []byte("{}")},
+					Shoot:        runtime.RawExtension{Raw: shootRaw},
+				},
+			},
+		).Build()
+
+		shootClient = fakeclient.NewClientBuilder().WithScheme(certserviceclient.ClusterScheme).WithObjects(
+			namespaceWithLabels("default", nil),
+			namespaceWithLabels("app1", map[string]string{"team": "a"}),
+			namespaceWithLabels("app2", map[string]string{"team": "a"}),
+			namespaceWithLabels("app3", map[string]string{"team": "b"}),
+			tlsSecret("default", "wildcard-tls", "cert1"),
+		).Build()
+
+		r = &Reconciler{
+			Client:            seedClient,
+			CertConfigDecoder: shared.NewCertConfigDecoderForScheme(scheme, config.Configuration{IssuerName: "garden"}),
+			SyncPeriod:        time.Minute,
+			NewShootClient: func(_ context.Context, _ string) (client.Client, error) {
+				return shootClient, nil
+			},
+		}
+	})
+
+	It("should replicate the configured secret and update the replicas on renewal", func() {
+		reconcileExtension(time.Minute)
+
+		for _, ns := range []string{"app1", "app2"} {
+			replica := getSecret(ns, "wildcard-tls")
+			Expect(replica.Type).To(Equal(corev1.SecretTypeTLS))
+			Expect(replica.Labels).To(HaveKeyWithValue("service.cert.extensions.gardener.cloud/replica", "true"))
+			Expect(replica.Annotations).To(HaveKeyWithValue("service.cert.extensions.gardener.cloud/replicated-from", "default/wildcard-tls"))
+			Expect(replica.Data).To(HaveKeyWithValue("tls.crt", []byte("cert1")))
+		}
+		Expect(shootClient.Get(ctx, client.ObjectKey{Namespace: "app3", Name: "wildcard-tls"}, &corev1.Secret{})).To(BeNotFoundError())
+
+		source := getSecret("default", "wildcard-tls")
+		source.Data[corev1.TLSCertKey] = []byte("cert2")
+		Expect(shootClient.Update(ctx, source)).To(Succeed())
+		reconcileExtension(time.Minute)
+
+		Expect(getSecret("app1", "wildcard-tls").Data).To(HaveKeyWithValue("tls.crt", []byte("cert2")))
+	})
+
+	It("should replicate the secret of an annotated certificate", func() {
+		Expect(shootClient.Create(ctx, &certv1alpha1.Certificate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "shared",
+				Namespace:   "app3",
+				Annotations: map[string]string{"service.cert.extensions.gardener.cloud/replicate-to-namespaces": "team=a"},
+			},
+			Spec: certv1alpha1.CertificateSpec{SecretName: new("shared-tls")},
+		})).To(Succeed())
+		Expect(shootClient.Create(ctx, tlsSecret("app3", "shared-tls", "shared"))).To(Succeed())
+
+		reconcileExtension(time.Minute)
+
+		Expect(getSecret("app1", "shared-tls").Data).To(HaveKeyWithValue("tls.crt", []byte("shared")))
+		Expect(getSecret("app2", "shared-tls").Data).To(HaveKeyWithValue("tls.crt", []byte("shared")))
+	})
+
+	It("should not overwrite an existing secret which is no replica", func() {
+		Expect(shootClient.Create(ctx, tlsSecret("app1", "wildcard-tls", "own"))).To(Succeed())
+
+		reconcileExtension(time.Minute)
+
+		Expect(getSecret("app1", "wildcard-tls").Data).To(HaveKeyWithValue("tls.crt", []byte("own")))
+		Expect(getSecret("app2", "wildcard-tls").Data).To(HaveKeyWithValue("tls.crt", []byte("cert1")))
+	})
+
+	It("should delete replicas which are not desired anymore", func() {
+		reconcileExtension(time.Minute)
+
+		ns := &corev1.Namespace{}
+		Expect(shootClient.Get(ctx, client.ObjectKey{Name: "app2"}, ns)).To(Succeed())
+		ns.Labels = nil
+		Expect(shootClient.Update(ctx, ns)).To(Succeed())
+		reconcileExtension(time.Minute)
+
+		Expect(shootClient.Get(ctx, client.ObjectKey{Namespace: "app2", Name: "wildcard-tls"}, &corev1.Secret{})).To(BeNotFoundError())
+		getSecret("app1", "wildcard-tls")
+
+	})
+
+	It("should not access the shoot cluster if the replication is disabled", func() {
+		ex.Spec.ProviderConfig = nil
+		Expect(seedClient.Update(ctx, ex)).To(Succeed())
+		r.NewShootClient = func(_ context.Context, _ string) (client.Client, error) {
+			Fail("shoot client must not be created")
+			return nil, nil
+		}
+
+		reconcileExtension(0)
+	})
+
+	It("should not replicate secrets which are not issued for a certificate", func() {
+		source := getSecret("default", "wildcard-tls")
+		source.Labels = nil
+		Expect(shootClient.Update(ctx, source)).To(Succeed())
+
+		reconcileExtension(time.Minute)
+
+		Expect(shootClient.Get(ctx, client.ObjectKey{Namespace: "app1", Name: "wildcard-tls"}, &corev1.Secret{})).To(BeNotFoundError())
+	})
+
+	It("should not replicate the secret of an annotated certificate in another namespace", func() {
+		Expect(shootClient.Create(ctx, &certv1alpha1.Certificate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "shared",
+				Namespace:   "app3",
+				Annotations: map[string]string{"service.cert.extensions.gardener.cloud/replicate-to-namespaces": "team=a"},
+			},
+			Spec: certv1alpha1.CertificateSpec{SecretRef: &corev1.SecretReference{Name: "shared-tls", Namespace: "default"}},
+		})).To(Succeed())
+		Expect(shootClient.Create(ctx, tlsSecret("default", "shared-tls", "shared"))).To(Succeed())
+
+		reconcileExtension(time.Minute)
+
+		Expect(shootClient.Get(ctx, client.ObjectKey{Namespace: "app1", Name: "shared-tls"}, &corev1.Secret{})).To(BeNotFoundError())
+	})
+})
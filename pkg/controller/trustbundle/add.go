@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package trustbundle
+
+import (
+	"context"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+	certserviceclient "github.com/gardener/gardener-extension-shoot-cert-service/pkg/client"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shared"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shoot"
+)
+
+const (
+	// ControllerName is the name of the controller copying the trust bundle into the namespaces of shoot clusters.
+	ControllerName = "trust-bundle"
+)
+
+var (
+	// changes of the namespaces and of the trust bundle are watched, the periodic sync only repairs modified copies
+	defaultSyncPeriod = 30 * time.Minute
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{SyncPeriod: defaultSyncPeriod}
+)
+
+// AddOptions are options to apply when adding the trust bundle controller to the manager.
+type AddOptions struct {
+	// ControllerOptions contains options for the controller.
+	ControllerOptions controller.Options
+	// ServiceConfig contains configuration for the shoot cert service.
+	ServiceConfig config.Configuration
+	// SyncPeriod is the period for copying the trust bundle of a shoot cluster.
+	SyncPeriod time.Duration
+}
+
+// AddToManager adds a controller with the default Options to the given Controller Manager.
+func AddToManager(ctx context.Context, mgr manager.Manager) error {
+	return AddToManagerWithOptions(ctx, mgr, DefaultAddOptions)
+}
+
+// AddToManagerWithOptions adds a controller with the given Options to the given manager.
+// The watches of the shoot clusters are running until the given context is cancelled.
+func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
+	events := make(chan event.GenericEvent)
+	r := &Reconciler{
+		Client:            mgr.GetClient(),
+		CertConfigDecoder: shared.NewCertConfigDecoder(mgr, opts.ServiceConfig),
+		SyncPeriod:        opts.SyncPeriod,
+		NewShootClient: func(ctx context.Context, namespace string) (client.Client, error) {
+			return shoot.NewShootAccessClient(ctx, mgr.GetClient(), namespace, client.Options{Scheme: certserviceclient.ClusterScheme})
+		},
+		Watcher: NewShootWatcher(ctx, events, func(ctx context.Context, namespace string) (*rest.Config, error) {
+			return shoot.NewShootAccessRESTConfig(ctx, mgr.GetClient(), namespace)
+		}),
+	}
+
+	return builder.ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&extensionsv1alpha1.Extension{}, builder.WithPredicates(
+			predicateutils.HasType(shoot.Type),
+			predicateutils.HasClass(extensionsv1alpha1.ExtensionClassShoot),
+			predicate.GenerationChangedPredicate{},
+		)).
+		WatchesRawSource(source.Channel(events, &handler.EnqueueRequestForObject{})).
+		WithOptions(opts.ControllerOptions).
+		Complete(r)
+}
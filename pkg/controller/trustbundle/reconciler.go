@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package trustbundle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shared"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shoot"
+)
+
+// Reconciler copies the trust bundle config map into the namespaces of the shoot cluster selected by the namespace
+// selector of the trust bundle. The namespaces and the trust bundle are watched, so that new namespaces get a copy and
+// the copies are updated when the CA certificates change.
+type Reconciler struct {
+	// Client is the client for the seed cluster.
+	Client            client.Client
+	CertConfigDecoder shared.CertConfigDecoder
+	SyncPeriod        time.Duration
+	// NewShootClient creates a client for the shoot cluster of the given shoot namespace in the seed,
+	// which has the permissions of the shoot access secret of the extension.
+	NewShootClient func(ctx context.Context, namespace string) (client.Client, error)
+	// Watcher watches the namespaces and the trust bundle of the shoot clusters.
+	Watcher ShootWatcher
+}
+
+// Reconcile copies the trust bundle in the shoot cluster belonging to the extension.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ex := &extensionsv1alpha1.Extension{}
+	if err := r.Client.Get(ctx, request.NamespacedName, ex); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.Watcher.Stop(request.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving extension: %w", err)
+	}
+	if ex.DeletionTimestamp != nil {
+		r.Watcher.Stop(request.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, r.Client, ex.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if cluster.Shoot.DeletionTimestamp != nil {
+		r.Watcher.Stop(request.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	certConfig, err := r.CertConfigDecoder.DecodeAndValidateProviderConfig(ex, cluster)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !shared.TrustBundleCopiesEnabled(certConfig) {
+		// copies of a previously enabled trust bundle are deleted by the extension actuator
+		r.Watcher.Stop(request.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+	if extensionscontroller.IsHibernationEnabled(cluster) {
+		// the generation of the extension is not changed on wake up, so the shoot cluster is checked periodically
+		r.Watcher.Stop(request.NamespacedName)
+		return reconcile.Result{RequeueAfter: r.SyncPeriod}, nil
+	}
+
+	if err := r.Watcher.Watch(ctx, request.NamespacedName); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to watch shoot cluster: %w", err)
+	}
+	shootClient, err := r.NewShootClient(ctx, ex.Namespace)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to create shoot client: %w", err)
+	}
+	if err := shoot.CopyTrustBundle(ctx, log, shootClient, certConfig.TrustBundle); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{RequeueAfter: r.SyncPeriod}, nil
+}
@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package trustbundle
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/install"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/v1alpha1"
+	certserviceclient "github.com/gardener/gardener-extension-shoot-cert-service/pkg/client"
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/controller/extension/shared"
+)
+
+type fakeWatcher struct {
+	watched map[client.ObjectKey]bool
+}
+
+func (w *fakeWatcher) Watch(_ context.Context, key client.ObjectKey) error {
+	w.watched[key] = true
+	return nil
+}
+
+func (w *fakeWatcher) Stop(key client.ObjectKey) {
+	w.watched[key] = false
+}
+
+var _ = Describe("Reconciler", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx         = context.Background()
+		seedClient  client.Client
+		shootClient client.Client
+		watcher     *fakeWatcher
+		r           *Reconciler
+		ex          *extensionsv1alpha1.Extension
+		shoot       *gardencorev1beta1.Shoot
+
+		providerConfig = `{"apiVersion":"service.cert.extensions.gardener.cloud/v1alpha1","kind":"CertConfig",
+"trustBundle":{"enabled":true,"namespaceSelector":{"matchLabels":{"team":"a"}}}}`
+
+		reconcileExtension = func(expectedRequeueAfter time.Duration) {
+			result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(ex)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(expectedRequeueAfter))
+		}
+		createCluster = func() {
+			shootRaw, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(seedClient.Create(ctx, &extensionsv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: namespace},
+				Spec: extensionsv1alpha1.ClusterSpec{
+					CloudProfile: runtime.RawExtension{Raw: []byte("{}")},
+					Seed:         &runtime.RawExtension{Raw: []byte("{}")},
+					Shoot:        runtime.RawExtension{Raw: shootRaw},
+				},
+			})).To(Succeed())
+		}
+		getConfigMap = func(namespace string) *corev1.ConfigMap {
+			configMap := &corev1.ConfigMap{}
+			Expect(shootClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: v1alpha1.TrustBundleConfigMapName}, configMap)).To(Succeed())
+			return configMap
+		}
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(kubernetesscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(install.AddToScheme(scheme)).To(Succeed())
+
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{DNS: &gardencorev1beta1.DNS{Domain: new("foo.example.com")}},
+		}
+		ex = &extensionsv1alpha1.Extension{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot-cert-service", Namespace: namespace},
+			Spec: extensionsv1alpha1.ExtensionSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:           "shoot-cert-service",
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(providerConfig)},
+				},
+			},
+		}
+		seedClient = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(ex).Build()
+
+		shootClient = fakeclient.NewClientBuilder().WithScheme(certserviceclient.ClusterScheme).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "app1", Labels: map[string]string{"team": "a"}}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "app2", Labels: map[string]string{"team": "b"}}},
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.TrustBundleConfigMapName, Namespace: "kube-system"},
+				Data:       map[string]string{"bundle.crt": "ca1"},
+			},
+		).Build()
+
+		watcher = &fakeWatcher{watched: map[client.ObjectKey]bool{}}
+		r = &Reconciler{
+			Client:            seedClient,
+			CertConfigDecoder: shared.NewCertConfigDecoderForScheme(scheme, config.Configuration{IssuerName: "garden"}),
+			SyncPeriod:        time.Minute,
+			NewShootClient: func(_ context.Context, _ string) (client.Client, error) {
+				return shootClient, nil
+			},
+			Watcher: watcher,
+		}
+	})
+
+	It("should watch the shoot cluster and copy the trust bundle into the selected namespaces", func() {
+		createCluster()
+
+		reconcileExtension(time.Minute)
+
+		Expect(watcher.watched).To(HaveKeyWithValue(client.ObjectKeyFromObject(ex), true))
+		Expect(getConfigMap("app1").Data).To(HaveKeyWithValue("bundle.crt", "ca1"))
+		Expect(shootClient.Get(ctx, client.ObjectKey{Namespace: "app2", Name: v1alpha1.TrustBundleConfigMapName}, &corev1.ConfigMap{})).NotTo(Succeed())
+
+		source := getConfigMap("kube-system")
+		source.Data["bundle.crt"] = "ca2"
+		Expect(shootClient.Update(ctx, source)).To(Succeed())
+		reconcileExtension(time.Minute)
+
+		Expect(getConfigMap("app1").Data).To(HaveKeyWithValue("bundle.crt", "ca2"))
+	})
+
+	It("should stop watching and not access the shoot cluster if the copies are disabled", func() {
+		createCluster()
+		watcher.watched[client.ObjectKeyFromObject(ex)] = true
+		ex.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"service.cert.extensions.gardener.cloud/v1alpha1","kind":"CertConfig","trustBundle":{"enabled":true}}`)}
+		Expect(seedClient.Update(ctx, ex)).To(Succeed())
+		r.NewShootClient = func(_ context.Context, _ string) (client.Client, error) {
+			Fail("shoot client must not be created")
+			return nil, nil
+		}
+
+		reconcileExtension(0)
+
+		Expect(watcher.watched).To(HaveKeyWithValue(client.ObjectKeyFromObject(ex), false))
+	})
+
+	It("should stop watching a hibernated shoot cluster and check it periodically", func() {
+		shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: new(true)}
+		createCluster()
+		watcher.watched[client.ObjectKeyFromObject(ex)] = true
+		r.NewShootClient = func(_ context.Context, _ string) (client.Client, error) {
+			Fail("shoot client must not be created")
+			return nil, nil
+		}
+
+		reconcileExtension(time.Minute)
+
+		Expect(watcher.watched).To(HaveKeyWithValue(client.ObjectKeyFromObject(ex), false))
+	})
+
+	It("should stop watching if the extension is deleted", func() {
+		watcher.watched[client.ObjectKeyFromObject(ex)] = true
+		Expect(seedClient.Delete(ctx, ex)).To(Succeed())
+
+		reconcileExtension(0)
+
+		Expect(watcher.watched).To(HaveKeyWithValue(client.ObjectKeyFromObject(ex), false))
+	})
+})
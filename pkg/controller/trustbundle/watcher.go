@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package trustbundle
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-shoot-cert-service/pkg/apis/service/v1alpha1"
+)
+
+// ShootWatcher watches the shoot clusters of Extension resources.
+type ShootWatcher interface {
+	// Watch starts watching the shoot cluster of the given Extension resource, if it is not watched yet.
+	Watch(ctx context.Context, key client.ObjectKey) error
+	// Stop stops watching the shoot cluster of the given Extension resource.
+	Stop(key client.ObjectKey)
+}
+
+// NewShootWatcher creates a watcher for the namespaces and the trust bundle config maps of shoot clusters.
+// On changes, an event for the Extension resource of the shoot cluster is sent to the given channel.
+// The watches are stopped when the given context is cancelled.
+func NewShootWatcher(ctx context.Context, events chan<- event.GenericEvent, newRESTConfig func(ctx context.Context, namespace string) (*rest.Config, error)) ShootWatcher {
+	return &shootWatcher{
+		ctx:           ctx,
+		events:        events,
+		newRESTConfig: newRESTConfig,
+		watches:       map[client.ObjectKey]*shootWatch{},
+	}
+}
+
+// cacheSyncTimeout is the maximum time to wait for the initial sync of the cache of a shoot cluster.
+const cacheSyncTimeout = 1 * time.Minute
+
+type shootWatcher struct {
+	ctx           context.Context
+	events        chan<- event.GenericEvent
+	newRESTConfig func(ctx context.Context, namespace string) (*rest.Config, error)
+
+	lock    sync.Mutex
+	watches map[client.ObjectKey]*shootWatch
+}
+
+type shootWatch struct {
+	cancel context.CancelFunc
+}
+
+func (w *shootWatcher) Watch(ctx context.Context, key client.ObjectKey) error {
+	watch, shootCache, err := w.startWatch(ctx, key)
+	if err != nil || watch == nil {
+		return err
+	}
+
+	// the cache is synced outside the lock, so that unreachable shoot clusters do not block the watches of other shoots
+	syncCtx, cancel := context.WithTimeout(ctx, cacheSyncTimeout)
+	defer cancel()
+	if !shootCache.WaitForCacheSync(syncCtx) {
+		w.removeWatch(key, watch)
+		return fmt.Errorf("failed to sync cache for shoot cluster")
+	}
+	return nil
+}
+
+// startWatch starts the cache of the shoot cluster and registers the watch. If the shoot cluster is already watched,
+// nothing is returned.
+func (w *shootWatcher) startWatch(ctx context.Context, key client.ObjectKey) (*shootWatch, cache.Cache, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if _, ok := w.watches[key]; ok {
+		return nil, nil, nil
+	}
+
+	restConfig, err := w.newRESTConfig(ctx, key.Namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	shootCache, err := cache.New(restConfig, cache.Options{
+		Scheme: kubernetesscheme.Scheme,
+		ByObject: map[client.Object]cache.ByObject{
+			&corev1.ConfigMap{}: {Field: fields.OneTermEqualSelector(metav1.ObjectNameField, v1alpha1.TrustBundleConfigMapName)},
+		},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create cache for shoot cluster: %w", err)
+	}
+
+	watchCtx, cancel := context.WithCancel(w.ctx)
+	ex := &extensionsv1alpha1.Extension{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}
+	enqueue := func() {
+		select {
+		case w.events <- event.GenericEvent{Object: ex}:
+		case <-watchCtx.Done():
+		}
+	}
+	handler := toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    func(any) { enqueue() },
+		UpdateFunc: func(any, any) { enqueue() },
+		DeleteFunc: func(any) { enqueue() },
+	}
+	for _, obj := range []client.Object{&corev1.Namespace{}, &corev1.ConfigMap{}} {
+		informer, err := shootCache.GetInformer(ctx, obj, cache.BlockUntilSynced(false))
+		if err != nil {
+			cancel()
+			return nil, nil, fmt.Errorf("failed to get informer for shoot cluster: %w", err)
+		}
+		if _, err := informer.AddEventHandler(handler); err != nil {
+			cancel()
+			return nil, nil, fmt.Errorf("failed to add event handler for shoot cluster: %w", err)
+		}
+	}
+
+	watch := &shootWatch{cancel: cancel}
+	w.watches[key] = watch
+	go func() {
+		if err := shootCache.Start(watchCtx); err != nil {
+			logf.FromContext(w.ctx).Error(err, "Failed to watch shoot cluster", "extension", key)
+			w.removeWatch(key, watch)
+		}
+	}()
+	return watch, shootCache, nil
+}
+
+// removeWatch stops and removes the given watch, if it has not been replaced by another watch of the shoot cluster meanwhile.
+func (w *shootWatcher) removeWatch(key client.ObjectKey, watch *shootWatch) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	watch.cancel()
+	if w.watches[key] == watch {
+		delete(w.watches, key)
+	}
+}
+
+func (w *shootWatcher) Stop(key client.ObjectKey) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if watch, ok := w.watches[key]; ok {
+		watch.cancel()
+		delete(w.watches, key)
+	}
+}